@@ -53,6 +53,62 @@ func (a *AttachmentBuilder) AddDivider() *AttachmentBuilder {
 	return a
 }
 
+// AddHeader adds a header block to the attachment.
+func (a *AttachmentBuilder) AddHeader(text string) *AttachmentBuilder {
+	header := NewHeaderBlock(text)
+	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, header)
+	return a
+}
+
+// AddInput adds an input block to the attachment.
+func (a *AttachmentBuilder) AddInput(blockID, label string, element slack.BlockElement) *AttachmentBuilder {
+	input := NewInputBlock(blockID, label, element)
+	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, input)
+	return a
+}
+
+// AddRichText adds a rich text block to the attachment.
+func (a *AttachmentBuilder) AddRichText(elements ...slack.RichTextElement) *AttachmentBuilder {
+	richText := NewRichTextBlock(elements...)
+	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, richText)
+	return a
+}
+
+// AddVideo adds a video block to the attachment.
+func (a *AttachmentBuilder) AddVideo(videoURL, thumbnailURL, altText, title string) *AttachmentBuilder {
+	video := NewVideoBlock(videoURL, thumbnailURL, altText, title)
+	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, video)
+	return a
+}
+
+// AddFile adds a remote file block to the attachment.
+func (a *AttachmentBuilder) AddFile(externalID string) *AttachmentBuilder {
+	file := NewFileBlock(externalID)
+	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, file)
+	return a
+}
+
+// AddSectionFrom adds a section built with a SectionBuilder to the attachment, splitting it
+// into several sections if it has more than MaxSectionFields fields.
+func (a *AttachmentBuilder) AddSectionFrom(section *SectionBuilder) *AttachmentBuilder {
+	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, section.blocks()...)
+	return a
+}
+
+// AddFields adds label/value fields to the attachment, starting a new section
+// every MaxSectionFields fields.
+func (a *AttachmentBuilder) AddFields(fields ...Field) *AttachmentBuilder {
+	if len(fields) == 0 {
+		return a
+	}
+	return a.AddSectionFrom(NewSection().Fields(fields...))
+}
+
+// AddFieldsMap adds fields from a map to the attachment, ordered by label.
+func (a *AttachmentBuilder) AddFieldsMap(fields map[string]string) *AttachmentBuilder {
+	return a.AddFields(sortedFields(fields)...)
+}
+
 // AddBlock allows adding a custom block built using BlockBuilder pattern.
 func (a *AttachmentBuilder) AddBlock(customBlock slack.Block) *AttachmentBuilder {
 	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, customBlock)
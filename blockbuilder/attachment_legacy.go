@@ -0,0 +1,133 @@
+package blockbuilder
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// Legacy attachment fields whose text can be formatted with mrkdwn.
+const (
+	MarkdownInPretext = "pretext"
+	MarkdownInText    = "text"
+	MarkdownInFields  = "fields"
+)
+
+// Fallback sets the plain-text summary shown where the attachment cannot be displayed.
+func (a *AttachmentBuilder) Fallback(text string) *AttachmentBuilder {
+	a.attachment.Fallback = text
+	return a
+}
+
+// Pretext sets the text shown above the attachment.
+func (a *AttachmentBuilder) Pretext(text string) *AttachmentBuilder {
+	a.attachment.Pretext = text
+	return a
+}
+
+// Title sets the attachment's title, linked to link unless it is empty.
+func (a *AttachmentBuilder) Title(title, link string) *AttachmentBuilder {
+	a.attachment.Title = title
+	a.attachment.TitleLink = link
+	return a
+}
+
+// Text sets the attachment's main text.
+func (a *AttachmentBuilder) Text(text string) *AttachmentBuilder {
+	a.attachment.Text = text
+	return a
+}
+
+// Field adds a legacy field. Short fields are shown side by side.
+func (a *AttachmentBuilder) Field(title, value string, short bool) *AttachmentBuilder {
+	return a.Fields(slack.AttachmentField{Title: title, Value: value, Short: short})
+}
+
+// Fields adds legacy fields.
+func (a *AttachmentBuilder) Fields(fields ...slack.AttachmentField) *AttachmentBuilder {
+	a.attachment.Fields = append(a.attachment.Fields, fields...)
+	return a
+}
+
+// Author sets the author shown at the top of the attachment, with an optional link and icon.
+func (a *AttachmentBuilder) Author(name, link, iconURL string) *AttachmentBuilder {
+	a.attachment.AuthorName = name
+	a.attachment.AuthorLink = link
+	a.attachment.AuthorIcon = iconURL
+	return a
+}
+
+// Footer sets the footer text and an optional icon.
+func (a *AttachmentBuilder) Footer(text, iconURL string) *AttachmentBuilder {
+	a.attachment.Footer = text
+	a.attachment.FooterIcon = iconURL
+	return a
+}
+
+// Timestamp sets the time shown in the footer.
+func (a *AttachmentBuilder) Timestamp(t time.Time) *AttachmentBuilder {
+	a.attachment.Ts = json.Number(strconv.FormatInt(t.Unix(), 10))
+	return a
+}
+
+// MarkdownIn enables mrkdwn in the given fields: MarkdownInPretext, MarkdownInText or
+// MarkdownInFields.
+func (a *AttachmentBuilder) MarkdownIn(fields ...string) *AttachmentBuilder {
+	a.attachment.MarkdownIn = append(a.attachment.MarkdownIn, fields...)
+	return a
+}
+
+// validateLegacyAttachment reports legacy fields that are ignored next to blocks, links and
+// icons without the text they belong to, and unknown mrkdwn_in fields.
+func (v *validator) validateLegacyAttachment(attachment slack.Attachment) {
+	var legacy []string
+	for _, field := range []struct {
+		name string
+		set  bool
+	}{
+		{"author_name", attachment.AuthorName != ""},
+		{"title", attachment.Title != ""},
+		{"pretext", attachment.Pretext != ""},
+		{"text", attachment.Text != ""},
+		{"fields", len(attachment.Fields) > 0},
+		{"image_url", attachment.ImageURL != ""},
+		{"thumb_url", attachment.ThumbURL != ""},
+		{"footer", attachment.Footer != ""},
+		{"ts", attachment.Ts != ""},
+	} {
+		if field.set {
+			legacy = append(legacy, field.name)
+		}
+	}
+	if len(legacy) > 0 && len(attachment.Blocks.BlockSet) > 0 {
+		v.addf(-1, "", "legacy fields are ignored in an attachment with blocks: %s", strings.Join(legacy, ", "))
+	}
+
+	for _, dep := range []struct {
+		field, requires string
+		set, present    bool
+	}{
+		{"title_link", "title", attachment.TitleLink != "", attachment.Title != ""},
+		{"author_link", "author_name", attachment.AuthorLink != "", attachment.AuthorName != ""},
+		{"author_icon", "author_name", attachment.AuthorIcon != "", attachment.AuthorName != ""},
+		{"footer_icon", "footer", attachment.FooterIcon != "", attachment.Footer != ""},
+	} {
+		if dep.set && !dep.present {
+			v.addf(-1, "", "%s requires %s", dep.field, dep.requires)
+		}
+	}
+
+	for i, field := range attachment.Fields {
+		if field.Title == "" && field.Value == "" {
+			v.addf(-1, "", "field %d has no title or value", i)
+		}
+	}
+	for _, field := range attachment.MarkdownIn {
+		if field != MarkdownInPretext && field != MarkdownInText && field != MarkdownInFields {
+			v.addf(-1, "", "unknown mrkdwn_in field %q", field)
+		}
+	}
+}
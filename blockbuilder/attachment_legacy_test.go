@@ -0,0 +1,76 @@
+package blockbuilder_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+)
+
+func TestAttachmentBuilderLegacyFields(t *testing.T) {
+	attachment := blockbuilder.NewAttachmentBuilder(blockbuilder.ColorDanger).
+		Fallback("Disk full on db-1").
+		Pretext("New alert").
+		Title("Disk full", "https://example.com/alerts/1").
+		Text("*db-1* is at 98%").
+		Field("Host", "db-1", true).
+		Field("Usage", "98%", true).
+		Author("monitor", "https://example.com", "https://example.com/icon.png").
+		Footer("Alertmanager", "https://example.com/footer.png").
+		Timestamp(time.Unix(1700000000, 0)).
+		MarkdownIn(blockbuilder.MarkdownInText, blockbuilder.MarkdownInFields)
+
+	if err := attachment.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	data, err := json.Marshal(attachment.Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"color":"#ff0000","fallback":"Disk full on db-1","author_name":"monitor","author_link":"https://example.com",` +
+		`"author_icon":"https://example.com/icon.png","title":"Disk full","title_link":"https://example.com/alerts/1",` +
+		`"pretext":"New alert","text":"*db-1* is at 98%","fields":[{"title":"Host","value":"db-1","short":true},` +
+		`{"title":"Usage","value":"98%","short":true}],"mrkdwn_in":["text","fields"],"blocks":null,` +
+		`"footer":"Alertmanager","footer_icon":"https://example.com/footer.png","ts":1700000000}`
+	if string(data) != want {
+		t.Errorf("JSON =\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestAttachmentBuilderValidateLegacyFields(t *testing.T) {
+	err := blockbuilder.NewAttachmentBuilder(blockbuilder.ColorGood).
+		AddSection("blocks", false).
+		Text("ignored").
+		Field("", "", false).
+		Title("", "https://example.com").
+		Footer("", "https://example.com/icon.png").
+		MarkdownIn("title").
+		Validate()
+
+	var errs blockbuilder.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate = %v, want ValidationErrors", err)
+	}
+	for _, want := range []string{
+		"legacy fields are ignored in an attachment with blocks: text, fields",
+		"title_link requires title",
+		"footer_icon requires footer",
+		"field 0 has no title or value",
+		`unknown mrkdwn_in field "title"`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate missing %q in:\n%v", want, err)
+		}
+	}
+	if len(errs) != 5 {
+		t.Errorf("got %d errors, want 5:\n%v", len(errs), err)
+	}
+
+	if err := blockbuilder.NewAttachmentBuilder("").Fallback("only blocks").AddDivider().Validate(); err != nil {
+		t.Errorf("fallback with blocks: %v", err)
+	}
+}
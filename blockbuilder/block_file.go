@@ -0,0 +1,10 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// NewFileBlock creates a file block for a remote file added with files.remote.add.
+func NewFileBlock(externalID string) *slack.FileBlock {
+	return slack.NewFileBlock("", externalID, "remote")
+}
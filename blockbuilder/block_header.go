@@ -0,0 +1,10 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// NewHeaderBlock creates a header block with plain text.
+func NewHeaderBlock(text string) *slack.HeaderBlock {
+	return slack.NewHeaderBlock(slack.NewTextBlockObject("plain_text", text, true, false))
+}
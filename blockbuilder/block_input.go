@@ -0,0 +1,10 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// NewInputBlock creates an input block with a label and an input element (e.g., a text input or select).
+func NewInputBlock(blockID, label string, element slack.BlockElement) *slack.InputBlock {
+	return slack.NewInputBlock(blockID, slack.NewTextBlockObject("plain_text", label, true, false), nil, element)
+}
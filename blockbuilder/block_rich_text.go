@@ -0,0 +1,10 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// NewRichTextBlock creates a rich text block with rich text elements (sections, lists, quotes, preformatted text).
+func NewRichTextBlock(elements ...slack.RichTextElement) *slack.RichTextBlock {
+	return slack.NewRichTextBlock("", elements...)
+}
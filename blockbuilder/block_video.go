@@ -0,0 +1,10 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// NewVideoBlock creates a video block with a video URL, thumbnail URL, alt text and title.
+func NewVideoBlock(videoURL, thumbnailURL, altText, title string) *slack.VideoBlock {
+	return slack.NewVideoBlock(videoURL, thumbnailURL, altText, "", slack.NewTextBlockObject("plain_text", title, true, false))
+}
@@ -0,0 +1,89 @@
+package blockbuilder_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestBlockConstructorsJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		block slack.Block
+		want  string
+	}{
+		{
+			name:  "header",
+			block: blockbuilder.NewHeaderBlock("Deploy :rocket:"),
+			want:  `{"type":"header","text":{"type":"plain_text","text":"Deploy :rocket:","emoji":true}}`,
+		},
+		{
+			name:  "input",
+			block: blockbuilder.NewInputBlock("name", "Name", slack.NewPlainTextInputBlockElement(nil, "name_input")),
+			want: `{"type":"input","block_id":"name","label":{"type":"plain_text","text":"Name","emoji":true},` +
+				`"element":{"type":"plain_text_input","action_id":"name_input"}}`,
+		},
+		{
+			name:  "rich text",
+			block: blockbuilder.NewRichTextBlock(slack.NewRichTextSection(slack.NewRichTextSectionTextElement("hi", nil))),
+			want:  `{"type":"rich_text","elements":[{"type":"rich_text_section","elements":[{"type":"text","text":"hi"}]}]}`,
+		},
+		{
+			name:  "video",
+			block: blockbuilder.NewVideoBlock("https://ex.com/v", "https://ex.com/t.png", "demo", "Demo"),
+			want: `{"type":"video","video_url":"https://ex.com/v","thumbnail_url":"https://ex.com/t.png","alt_text":"demo",` +
+				`"title":{"type":"plain_text","text":"Demo","emoji":true}}`,
+		},
+		{
+			name:  "file",
+			block: blockbuilder.NewFileBlock("ext-1"),
+			want:  `{"type":"file","external_id":"ext-1","source":"remote"}`,
+		},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.block)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if string(data) != tt.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", tt.name, data, tt.want)
+		}
+	}
+}
+
+func TestAddBlockMethods(t *testing.T) {
+	input := slack.NewPlainTextInputBlockElement(nil, "name_input")
+	section := slack.NewRichTextSection(slack.NewRichTextSectionTextElement("hi", nil))
+	want := []slack.Block{
+		blockbuilder.NewHeaderBlock("Title"),
+		blockbuilder.NewInputBlock("name", "Name", input),
+		blockbuilder.NewRichTextBlock(section),
+		blockbuilder.NewVideoBlock("https://ex.com/v", "https://ex.com/t.png", "demo", "Demo"),
+		blockbuilder.NewFileBlock("ext-1"),
+	}
+
+	blocks := blockbuilder.NewBlockBuilder().
+		AddHeader("Title").
+		AddInput("name", "Name", input).
+		AddRichText(section).
+		AddVideo("https://ex.com/v", "https://ex.com/t.png", "demo", "Demo").
+		AddFile("ext-1").
+		Build()
+	if !reflect.DeepEqual(blocks, want) {
+		t.Errorf("BlockBuilder blocks = %#v, want %#v", blocks, want)
+	}
+
+	attachment := blockbuilder.NewAttachmentBuilder("").
+		AddHeader("Title").
+		AddInput("name", "Name", input).
+		AddRichText(section).
+		AddVideo("https://ex.com/v", "https://ex.com/t.png", "demo", "Demo").
+		AddFile("ext-1").
+		Build()
+	if !reflect.DeepEqual(attachment.Blocks.BlockSet, want) {
+		t.Errorf("AttachmentBuilder blocks = %#v, want %#v", attachment.Blocks.BlockSet, want)
+	}
+}
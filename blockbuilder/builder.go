@@ -51,6 +51,62 @@ func (b *BlockBuilder) AddDivider() *BlockBuilder {
 	return b
 }
 
+// AddHeader adds a header block with plain text to the builder.
+func (b *BlockBuilder) AddHeader(text string) *BlockBuilder {
+	header := NewHeaderBlock(text)
+	b.blocks = append(b.blocks, header)
+	return b
+}
+
+// AddInput adds an input block with a label and input element to the builder.
+func (b *BlockBuilder) AddInput(blockID, label string, element slack.BlockElement) *BlockBuilder {
+	input := NewInputBlock(blockID, label, element)
+	b.blocks = append(b.blocks, input)
+	return b
+}
+
+// AddRichText adds a rich text block to the builder.
+func (b *BlockBuilder) AddRichText(elements ...slack.RichTextElement) *BlockBuilder {
+	richText := NewRichTextBlock(elements...)
+	b.blocks = append(b.blocks, richText)
+	return b
+}
+
+// AddVideo adds a video block to the builder.
+func (b *BlockBuilder) AddVideo(videoURL, thumbnailURL, altText, title string) *BlockBuilder {
+	video := NewVideoBlock(videoURL, thumbnailURL, altText, title)
+	b.blocks = append(b.blocks, video)
+	return b
+}
+
+// AddFile adds a remote file block to the builder.
+func (b *BlockBuilder) AddFile(externalID string) *BlockBuilder {
+	file := NewFileBlock(externalID)
+	b.blocks = append(b.blocks, file)
+	return b
+}
+
+// AddSectionFrom adds a section built with a SectionBuilder, splitting it into several
+// sections if it has more than MaxSectionFields fields.
+func (b *BlockBuilder) AddSectionFrom(section *SectionBuilder) *BlockBuilder {
+	b.blocks = append(b.blocks, section.blocks()...)
+	return b
+}
+
+// AddFields adds label/value fields in a two-column layout, starting a new section
+// every MaxSectionFields fields.
+func (b *BlockBuilder) AddFields(fields ...Field) *BlockBuilder {
+	if len(fields) == 0 {
+		return b
+	}
+	return b.AddSectionFrom(NewSection().Fields(fields...))
+}
+
+// AddFieldsMap adds fields from a map, ordered by label.
+func (b *BlockBuilder) AddFieldsMap(fields map[string]string) *BlockBuilder {
+	return b.AddFields(sortedFields(fields)...)
+}
+
 // Build returns the assembled blocks.
 func (b *BlockBuilder) Build() []slack.Block {
 	return b.blocks
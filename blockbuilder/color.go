@@ -1,5 +1,12 @@
 package blockbuilder
 
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
 // Common Slack color codes for attachments.
 const (
 	// Primary colors
@@ -9,10 +16,6 @@ const (
 	ColorInfo    = "#439fe0" // Blue for informational messages.
 
 	// Additional descriptive colors
-	ColorRed       = "#ff0000" // Same as ColorDanger.
-	ColorGreen     = "#36a64f" // Same as ColorGood.
-	ColorOrange    = "#ffae42" // Same as ColorWarning.
-	ColorBlue      = "#439fe0" // Same as ColorInfo.
 	ColorYellow    = "#ffff00" // Bright yellow for attention-grabbing messages.
 	ColorPurple    = "#800080" // Purple for special cases or emphasis.
 	ColorPink      = "#ff69b4" // Pink for playful or less formal contexts.
@@ -37,3 +40,172 @@ const (
 	ColorChocolate = "#d2691e" // Chocolate for rich or comforting messages.
 	ColorSlateGray = "#708090" // Slate gray for cool or subdued tones.
 )
+
+// Aliases of the primary colors.
+const (
+	// Deprecated: Use ColorDanger.
+	ColorRed = ColorDanger
+	// Deprecated: Use ColorGood.
+	ColorGreen = ColorGood
+	// Deprecated: Use ColorWarning.
+	ColorOrange = ColorWarning
+	// Deprecated: Use ColorInfo.
+	ColorBlue = ColorInfo
+)
+
+// Color is an RGB color for attachment color bars.
+type Color struct {
+	R, G, B uint8
+}
+
+// RGB returns the color with the given red, green and blue components.
+func RGB(r, g, b uint8) Color {
+	return Color{R: r, G: g, B: b}
+}
+
+// ParseColor parses a hex color such as "#36a64f", "36a64f" or "#3a4". Slack's named colors
+// "good", "warning" and "danger" have no fixed RGB value; pass them to NewAttachmentBuilder as is.
+func ParseColor(s string) (Color, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return Color{}, fmt.Errorf("invalid color %q: want #rrggbb or #rgb", s)
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("invalid color %q: want #rrggbb or #rgb", s)
+	}
+	return RGB(uint8(v>>16), uint8(v>>8), uint8(v)), nil
+}
+
+// MustParseColor is like ParseColor but panics if s is not a valid color.
+func MustParseColor(s string) Color {
+	c, err := ParseColor(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// HSL returns the color with hue h in degrees and saturation s and lightness l between 0 and 1.
+func HSL(h, s, l float64) Color {
+	h = math.Mod(math.Mod(h, 360)+360, 360) / 360
+	s, l = clamp01(s), clamp01(l)
+	if s == 0 {
+		return RGB(channel(l), channel(l), channel(l))
+	}
+	q := l + s - l*s
+	if l < 0.5 {
+		q = l * (1 + s)
+	}
+	p := 2*l - q
+	return RGB(channel(hueToRGB(p, q, h+1.0/3)), channel(hueToRGB(p, q, h)), channel(hueToRGB(p, q, h-1.0/3)))
+}
+
+// HSL returns the color's hue in degrees and its saturation and lightness between 0 and 1.
+func (c Color) HSL() (h, s, l float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	maxC, minC := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l = (maxC + minC) / 2
+	if maxC == minC {
+		return 0, 0, l
+	}
+	d := maxC - minC
+	if l > 0.5 {
+		s = d / (2 - maxC - minC)
+	} else {
+		s = d / (maxC + minC)
+	}
+	switch maxC {
+	case r:
+		h = math.Mod((g-b)/d+6, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return h * 60, s, l
+}
+
+// Lighten raises the color's lightness by amount, between 0 and 1.
+func (c Color) Lighten(amount float64) Color {
+	h, s, l := c.HSL()
+	return HSL(h, s, l+amount)
+}
+
+// Darken lowers the color's lightness by amount, between 0 and 1.
+func (c Color) Darken(amount float64) Color {
+	return c.Lighten(-amount)
+}
+
+// Mix blends the color with other, from the color at t=0 to other at t=1.
+func (c Color) Mix(other Color, t float64) Color {
+	t = clamp01(t)
+	mix := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a) + (float64(b)-float64(a))*t))
+	}
+	return RGB(mix(c.R, other.R), mix(c.G, other.G), mix(c.B, other.B))
+}
+
+// Hex returns the color as "#rrggbb".
+func (c Color) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// String returns the color as "#rrggbb".
+func (c Color) String() string {
+	return c.Hex()
+}
+
+// Gradient returns the color at position t between 0 and 1 on a gradient through stops
+// spaced evenly.
+func Gradient(t float64, stops ...Color) Color {
+	switch len(stops) {
+	case 0:
+		return Color{}
+	case 1:
+		return stops[0]
+	}
+	pos := clamp01(t) * float64(len(stops)-1)
+	i := int(pos)
+	if i == len(stops)-1 {
+		return stops[i]
+	}
+	return stops[i].Mix(stops[i+1], pos-float64(i))
+}
+
+// NewAttachmentBuilderWithColor initializes a new attachment builder with a Color,
+// e.g. SeverityColor(SeverityHigh).
+func NewAttachmentBuilderWithColor(color Color) *AttachmentBuilder {
+	return NewAttachmentBuilder(color.Hex())
+}
+
+// hueToRGB computes one RGB component for HSL.
+func hueToRGB(p, q, t float64) float64 {
+	t = math.Mod(t+1, 1)
+	switch {
+	case t < 1.0/6:
+		return p + (q-p)*6*t
+	case t < 1.0/2:
+		return q
+	case t < 2.0/3:
+		return p + (q-p)*(2.0/3-t)*6
+	default:
+		return p
+	}
+}
+
+// channel converts a component between 0 and 1 to a byte.
+func channel(v float64) uint8 {
+	return uint8(math.Round(clamp01(v) * 255))
+}
+
+// clamp01 limits v to the range 0 to 1, treating NaN as 0.
+func clamp01(v float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+	return math.Max(0, math.Min(1, v))
+}
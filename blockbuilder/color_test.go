@@ -0,0 +1,96 @@
+package blockbuilder_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		in   string
+		want blockbuilder.Color
+	}{
+		{"#36a64f", blockbuilder.RGB(0x36, 0xa6, 0x4f)},
+		{"36A64F", blockbuilder.RGB(0x36, 0xa6, 0x4f)},
+		{"#3a4", blockbuilder.RGB(0x33, 0xaa, 0x44)},
+	}
+	for _, tt := range tests {
+		got, err := blockbuilder.ParseColor(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseColor(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []string{"", "#12345", "#gggggg", "red", "danger"} {
+		if _, err := blockbuilder.ParseColor(in); err == nil {
+			t.Errorf("ParseColor(%q) succeeded", in)
+		}
+	}
+}
+
+func TestColorHSL(t *testing.T) {
+	c := blockbuilder.MustParseColor(blockbuilder.ColorInfo)
+	h, s, l := c.HSL()
+	if got := blockbuilder.HSL(h, s, l); got != c {
+		t.Errorf("HSL round trip = %v, want %v", got, c)
+	}
+	if got := blockbuilder.HSL(120, 1, 0.5).Hex(); got != "#00ff00" {
+		t.Errorf("HSL(120, 1, 0.5) = %s", got)
+	}
+	if h, s, l := blockbuilder.RGB(255, 0, 0).HSL(); h != 0 || s != 1 || l != 0.5 {
+		t.Errorf("red HSL = %v, %v, %v", h, s, l)
+	}
+
+	red := blockbuilder.RGB(255, 0, 0)
+	if got := red.Lighten(0.25).Hex(); got != "#ff8080" {
+		t.Errorf("Lighten = %s", got)
+	}
+	if got := red.Darken(0.25).Hex(); got != "#800000" {
+		t.Errorf("Darken = %s", got)
+	}
+	if got := red.Lighten(2); got != blockbuilder.RGB(255, 255, 255) {
+		t.Errorf("Lighten clamps: %v", got)
+	}
+}
+
+func TestSeverityColor(t *testing.T) {
+	if got := blockbuilder.SeverityColor(blockbuilder.SeverityCritical).Hex(); got != blockbuilder.ColorDanger {
+		t.Errorf("critical = %s", got)
+	}
+	if got := blockbuilder.SeverityColor(99); got != blockbuilder.SeverityColor(blockbuilder.SeverityCritical) {
+		t.Errorf("out of range = %v", got)
+	}
+
+	level, err := blockbuilder.ParseSeverity("HIGH")
+	if err != nil || level != blockbuilder.SeverityHigh || level.String() != "high" {
+		t.Errorf("ParseSeverity = %v, %v", level, err)
+	}
+	if _, err := blockbuilder.ParseSeverity("urgent"); err == nil {
+		t.Error("ParseSeverity accepted unknown level")
+	}
+
+	if got := blockbuilder.ScoreColor(0).Hex(); got != blockbuilder.ColorGood {
+		t.Errorf("score 0 = %s", got)
+	}
+	if got := blockbuilder.ScoreColor(50).Hex(); got != blockbuilder.ColorWarning {
+		t.Errorf("score 50 = %s", got)
+	}
+	if got := blockbuilder.ScoreColor(math.NaN()).Hex(); got != blockbuilder.ColorGood {
+		t.Errorf("score NaN = %s", got)
+	}
+	if got := blockbuilder.ScoreColor(math.Inf(1)).Hex(); got != blockbuilder.ColorDanger {
+		t.Errorf("score +Inf = %s", got)
+	}
+	mid := blockbuilder.ScoreColor(25)
+	if mid == blockbuilder.ScoreColor(0) || mid == blockbuilder.ScoreColor(50) {
+		t.Errorf("score 25 not interpolated: %v", mid)
+	}
+}
+
+func TestNewAttachmentBuilderWithColor(t *testing.T) {
+	attachment := blockbuilder.NewAttachmentBuilderWithColor(blockbuilder.SeverityColor(blockbuilder.SeverityHigh)).Build()
+	if attachment.Color != "#ff6633" {
+		t.Errorf("Color = %q", attachment.Color)
+	}
+}
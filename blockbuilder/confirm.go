@@ -0,0 +1,136 @@
+package blockbuilder
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+)
+
+// ConfirmBuilder builds a confirmation dialog shown before an element's action is sent.
+type ConfirmBuilder struct {
+	confirm *slack.ConfirmationBlockObject
+}
+
+// NewConfirm initializes a ConfirmBuilder with plain text for the title, body and buttons.
+func NewConfirm(title, text, confirm, deny string) *ConfirmBuilder {
+	return &ConfirmBuilder{
+		confirm: slack.NewConfirmationBlockObject(
+			slack.NewTextBlockObject("plain_text", title, true, false),
+			slack.NewTextBlockObject("plain_text", text, true, false),
+			slack.NewTextBlockObject("plain_text", confirm, true, false),
+			slack.NewTextBlockObject("plain_text", deny, true, false),
+		),
+	}
+}
+
+// Danger gives the confirm button the red danger style, for destructive actions.
+func (c *ConfirmBuilder) Danger() *ConfirmBuilder {
+	c.confirm.Style = slack.StyleDanger
+	return c
+}
+
+// Primary gives the confirm button the green primary style.
+func (c *ConfirmBuilder) Primary() *ConfirmBuilder {
+	c.confirm.Style = slack.StylePrimary
+	return c
+}
+
+// Validate checks the dialog's text against Slack's length limits.
+// It returns nil or a ValidationErrors listing every violation.
+func (c *ConfirmBuilder) Validate() error {
+	v := &validator{}
+	v.validateConfirm(-1, "", c.confirm)
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// Build returns the confirmation dialog.
+func (c *ConfirmBuilder) Build() *slack.ConfirmationBlockObject {
+	return c.confirm
+}
+
+// AttachTo sets the dialog on element, which may be any button, select, overflow menu,
+// date, time or datetime picker, checkboxes or radio buttons, including those built by this package.
+// It returns an error if the dialog is invalid or element does not support confirmation.
+func (c *ConfirmBuilder) AttachTo(element slack.BlockElement) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	switch e := element.(type) {
+	case *slack.ButtonBlockElement:
+		e.Confirm = c.confirm
+	case *AccessibleButton:
+		e.Confirm = c.confirm
+	case *slack.SelectBlockElement:
+		e.Confirm = c.confirm
+	case *slack.MultiSelectBlockElement:
+		e.Confirm = c.confirm
+	case *slack.OverflowBlockElement:
+		e.Confirm = c.confirm
+	case *slack.DatePickerBlockElement:
+		e.Confirm = c.confirm
+	case *slack.TimePickerBlockElement:
+		e.Confirm = c.confirm
+	case *slack.DateTimePickerBlockElement:
+		e.Confirm = c.confirm
+	case *slack.CheckboxGroupsBlockElement:
+		e.Confirm = c.confirm
+	case *slack.RadioButtonsBlockElement:
+		e.Confirm = c.confirm
+	default:
+		return fmt.Errorf("element type %q does not support confirmation dialogs", element.ElementType())
+	}
+	return nil
+}
+
+// elementConfirm returns the confirmation dialog set on an element, if any.
+func elementConfirm(el slack.BlockElement) *slack.ConfirmationBlockObject {
+	switch e := el.(type) {
+	case *slack.ButtonBlockElement:
+		return e.Confirm
+	case *AccessibleButton:
+		return e.Confirm
+	case *slack.SelectBlockElement:
+		return e.Confirm
+	case *slack.MultiSelectBlockElement:
+		return e.Confirm
+	case *slack.OverflowBlockElement:
+		return e.Confirm
+	case *slack.DatePickerBlockElement:
+		return e.Confirm
+	case *slack.TimePickerBlockElement:
+		return e.Confirm
+	case *slack.DateTimePickerBlockElement:
+		return e.Confirm
+	case *slack.CheckboxGroupsBlockElement:
+		return e.Confirm
+	case *slack.RadioButtonsBlockElement:
+		return e.Confirm
+	default:
+		return nil
+	}
+}
+
+// validateConfirm checks that a confirmation dialog has all its text within limits.
+func (v *validator) validateConfirm(i int, id string, confirm *slack.ConfirmationBlockObject) {
+	parts := []struct {
+		name  string
+		text  *slack.TextBlockObject
+		limit int
+	}{
+		{"title", confirm.Title, MaxConfirmTitleLength},
+		{"text", confirm.Text, MaxConfirmTextLength},
+		{"confirm button", confirm.Confirm, MaxConfirmButtonLength},
+		{"deny button", confirm.Deny, MaxConfirmButtonLength},
+	}
+	for _, p := range parts {
+		if p.text == nil || p.text.Text == "" {
+			v.addf(i, id, "confirm dialog requires %s text", p.name)
+		} else if n := utf8.RuneCountInString(p.text.Text); n > p.limit {
+			v.addf(i, id, "confirm dialog %s is %d characters (max %d)", p.name, n, p.limit)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package blockbuilder_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestConfirmAttachTo(t *testing.T) {
+	confirm := blockbuilder.NewConfirm("Roll back?", "This reverts production to v1.2.", "Roll back", "Cancel").Danger()
+
+	button := blockbuilder.NewButtonBuilder("rollback", "Rollback").AccessibilityLabel("Roll back the deploy").Build()
+	overflow := blockbuilder.NewOverflow("more", blockbuilder.NewOption("delete", "Delete")).Build()
+	for _, el := range []slack.BlockElement{button, overflow} {
+		if err := confirm.AttachTo(el); err != nil {
+			t.Fatalf("attach to %s: %v", el.ElementType(), err)
+		}
+	}
+	if got := button.(*blockbuilder.AccessibleButton).Confirm; got == nil || got.Style != slack.StyleDanger {
+		t.Errorf("expected danger confirm on button, got %+v", got)
+	}
+
+	if err := confirm.AttachTo(blockbuilder.NewPlainTextInput("note").Build()); err == nil {
+		t.Error("expected error attaching to a plain text input")
+	}
+}
+
+func TestConfirmValidation(t *testing.T) {
+	confirm := blockbuilder.NewConfirm(strings.Repeat("t", 101), "Sure?", "Yes, delete everything now please", "")
+	err := confirm.Validate()
+
+	var verrs blockbuilder.ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 3 {
+		t.Fatalf("expected 3 violations, got %v", err)
+	}
+	if attachErr := confirm.AttachTo(blockbuilder.NewButton("delete", "Delete", "")); attachErr == nil {
+		t.Error("expected AttachTo to reject an invalid dialog")
+	}
+
+	button := blockbuilder.NewButtonBuilder("delete", "Delete").Confirm(confirm.Build()).Build()
+	if err := blockbuilder.NewBlockBuilder().AddActions(button).Validate(); err == nil {
+		t.Error("expected ValidateBlocks to report the invalid dialog")
+	}
+}
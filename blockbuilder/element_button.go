@@ -0,0 +1,88 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// AccessibleButton is a button element with an accessibility label, which
+// slack.ButtonBlockElement cannot carry. ButtonBuilder builds one instead of a
+// *slack.ButtonBlockElement only when a label is set. Use ButtonOf to inspect buttons
+// of either type.
+type AccessibleButton struct {
+	slack.ButtonBlockElement
+	AccessibilityLabel string `json:"accessibility_label,omitempty"`
+}
+
+// ButtonOf returns the button held by element: element itself if it is a
+// *slack.ButtonBlockElement, or the button embedded in an *AccessibleButton.
+func ButtonOf(element slack.BlockElement) (*slack.ButtonBlockElement, bool) {
+	switch e := element.(type) {
+	case *slack.ButtonBlockElement:
+		return e, true
+	case *AccessibleButton:
+		return &e.ButtonBlockElement, true
+	default:
+		return nil, false
+	}
+}
+
+// ButtonBuilder builds a button element.
+type ButtonBuilder struct {
+	button             *slack.ButtonBlockElement
+	accessibilityLabel string
+}
+
+// NewButtonBuilder initializes a ButtonBuilder for a button with plain text.
+func NewButtonBuilder(actionID, text string) *ButtonBuilder {
+	return &ButtonBuilder{
+		button: NewButton(actionID, text, ""),
+	}
+}
+
+// Value sets the value sent to the app when the button is clicked.
+func (b *ButtonBuilder) Value(value string) *ButtonBuilder {
+	b.button.Value = value
+	return b
+}
+
+// URL makes the button open url in the user's browser.
+func (b *ButtonBuilder) URL(url string) *ButtonBuilder {
+	b.button.URL = url
+	return b
+}
+
+// Primary gives the button the green primary style.
+func (b *ButtonBuilder) Primary() *ButtonBuilder {
+	b.button.Style = slack.StylePrimary
+	return b
+}
+
+// Danger gives the button the red danger style.
+func (b *ButtonBuilder) Danger() *ButtonBuilder {
+	b.button.Style = slack.StyleDanger
+	return b
+}
+
+// Confirm shows a confirmation dialog before the button's action is sent.
+func (b *ButtonBuilder) Confirm(confirm *slack.ConfirmationBlockObject) *ButtonBuilder {
+	b.button.Confirm = confirm
+	return b
+}
+
+// AccessibilityLabel sets the label read by screen readers instead of the button text.
+func (b *ButtonBuilder) AccessibilityLabel(label string) *ButtonBuilder {
+	b.accessibilityLabel = label
+	return b
+}
+
+// Build returns the button: a *slack.ButtonBlockElement, or an *AccessibleButton when an
+// accessibility label is set.
+func (b *ButtonBuilder) Build() slack.BlockElement {
+	if b.accessibilityLabel == "" {
+		return b.button
+	}
+	return &AccessibleButton{
+		ButtonBlockElement: *b.button,
+		AccessibilityLabel: b.accessibilityLabel,
+	}
+}
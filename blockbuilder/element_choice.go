@@ -0,0 +1,86 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// OverflowBuilder builds an overflow menu.
+type OverflowBuilder struct {
+	overflow *slack.OverflowBlockElement
+}
+
+// NewOverflow initializes an OverflowBuilder with its options.
+func NewOverflow(actionID string, options ...*slack.OptionBlockObject) *OverflowBuilder {
+	return &OverflowBuilder{
+		overflow: slack.NewOverflowBlockElement(actionID, options...),
+	}
+}
+
+// Confirm shows a confirmation dialog after an option is chosen.
+func (o *OverflowBuilder) Confirm(confirm *slack.ConfirmationBlockObject) *OverflowBuilder {
+	o.overflow.Confirm = confirm
+	return o
+}
+
+// Build returns the overflow menu.
+func (o *OverflowBuilder) Build() *slack.OverflowBlockElement {
+	return o.overflow
+}
+
+// CheckboxesBuilder builds a group of checkboxes.
+type CheckboxesBuilder struct {
+	checkboxes *slack.CheckboxGroupsBlockElement
+}
+
+// NewCheckboxes initializes a CheckboxesBuilder with its options.
+func NewCheckboxes(actionID string, options ...*slack.OptionBlockObject) *CheckboxesBuilder {
+	return &CheckboxesBuilder{
+		checkboxes: slack.NewCheckboxGroupsBlockElement(actionID, options...),
+	}
+}
+
+// InitialOptions checks options when the checkboxes are first shown.
+func (c *CheckboxesBuilder) InitialOptions(options ...*slack.OptionBlockObject) *CheckboxesBuilder {
+	c.checkboxes.InitialOptions = options
+	return c
+}
+
+// Confirm shows a confirmation dialog after a checkbox is toggled.
+func (c *CheckboxesBuilder) Confirm(confirm *slack.ConfirmationBlockObject) *CheckboxesBuilder {
+	c.checkboxes.Confirm = confirm
+	return c
+}
+
+// Build returns the checkboxes.
+func (c *CheckboxesBuilder) Build() *slack.CheckboxGroupsBlockElement {
+	return c.checkboxes
+}
+
+// RadioButtonsBuilder builds a group of radio buttons.
+type RadioButtonsBuilder struct {
+	radios *slack.RadioButtonsBlockElement
+}
+
+// NewRadioButtons initializes a RadioButtonsBuilder with its options.
+func NewRadioButtons(actionID string, options ...*slack.OptionBlockObject) *RadioButtonsBuilder {
+	return &RadioButtonsBuilder{
+		radios: slack.NewRadioButtonsBlockElement(actionID, options...),
+	}
+}
+
+// InitialOption selects option when the radio buttons are first shown.
+func (r *RadioButtonsBuilder) InitialOption(option *slack.OptionBlockObject) *RadioButtonsBuilder {
+	r.radios.InitialOption = option
+	return r
+}
+
+// Confirm shows a confirmation dialog after an option is selected.
+func (r *RadioButtonsBuilder) Confirm(confirm *slack.ConfirmationBlockObject) *RadioButtonsBuilder {
+	r.radios.Confirm = confirm
+	return r
+}
+
+// Build returns the radio buttons.
+func (r *RadioButtonsBuilder) Build() *slack.RadioButtonsBlockElement {
+	return r.radios
+}
@@ -0,0 +1,154 @@
+package blockbuilder
+
+import (
+	"strconv"
+
+	"github.com/slack-go/slack"
+)
+
+// PlainTextInputBuilder builds a plain text input.
+type PlainTextInputBuilder struct {
+	input *slack.PlainTextInputBlockElement
+}
+
+// NewPlainTextInput initializes a PlainTextInputBuilder.
+func NewPlainTextInput(actionID string) *PlainTextInputBuilder {
+	return &PlainTextInputBuilder{
+		input: slack.NewPlainTextInputBlockElement(nil, actionID),
+	}
+}
+
+// Placeholder sets the text shown while the input is empty.
+func (p *PlainTextInputBuilder) Placeholder(text string) *PlainTextInputBuilder {
+	p.input.Placeholder = slack.NewTextBlockObject("plain_text", text, true, false)
+	return p
+}
+
+// InitialValue prefills the input.
+func (p *PlainTextInputBuilder) InitialValue(value string) *PlainTextInputBuilder {
+	p.input.InitialValue = value
+	return p
+}
+
+// Multiline shows a larger, multi-line text area.
+func (p *PlainTextInputBuilder) Multiline() *PlainTextInputBuilder {
+	p.input.Multiline = true
+	return p
+}
+
+// Length sets the minimum and maximum number of characters accepted. Zero leaves a bound unset.
+func (p *PlainTextInputBuilder) Length(minLength, maxLength int) *PlainTextInputBuilder {
+	p.input.MinLength = minLength
+	p.input.MaxLength = maxLength
+	return p
+}
+
+// Build returns the plain text input.
+func (p *PlainTextInputBuilder) Build() *slack.PlainTextInputBlockElement {
+	return p.input
+}
+
+// NumberInputBuilder builds a number input.
+type NumberInputBuilder struct {
+	input *slack.NumberInputBlockElement
+}
+
+// NewNumberInput initializes a NumberInputBuilder that accepts whole numbers.
+func NewNumberInput(actionID string) *NumberInputBuilder {
+	return &NumberInputBuilder{
+		input: slack.NewNumberInputBlockElement(nil, actionID, false),
+	}
+}
+
+// Placeholder sets the text shown while the input is empty.
+func (n *NumberInputBuilder) Placeholder(text string) *NumberInputBuilder {
+	n.input.Placeholder = slack.NewTextBlockObject("plain_text", text, true, false)
+	return n
+}
+
+// Decimal accepts decimal numbers.
+func (n *NumberInputBuilder) Decimal() *NumberInputBuilder {
+	n.input.IsDecimalAllowed = true
+	return n
+}
+
+// InitialValue prefills the input.
+func (n *NumberInputBuilder) InitialValue(value float64) *NumberInputBuilder {
+	n.input.InitialValue = formatNumber(value)
+	return n
+}
+
+// Range sets the minimum and maximum accepted values.
+func (n *NumberInputBuilder) Range(minValue, maxValue float64) *NumberInputBuilder {
+	n.input.MinValue = formatNumber(minValue)
+	n.input.MaxValue = formatNumber(maxValue)
+	return n
+}
+
+// Build returns the number input.
+func (n *NumberInputBuilder) Build() *slack.NumberInputBlockElement {
+	return n.input
+}
+
+// EmailInputBuilder builds an email address input.
+type EmailInputBuilder struct {
+	input *slack.EmailTextInputBlockElement
+}
+
+// NewEmailInput initializes an EmailInputBuilder.
+func NewEmailInput(actionID string) *EmailInputBuilder {
+	return &EmailInputBuilder{
+		input: slack.NewEmailTextInputBlockElement(nil, actionID),
+	}
+}
+
+// Placeholder sets the text shown while the input is empty.
+func (e *EmailInputBuilder) Placeholder(text string) *EmailInputBuilder {
+	e.input.Placeholder = slack.NewTextBlockObject("plain_text", text, true, false)
+	return e
+}
+
+// InitialValue prefills the input.
+func (e *EmailInputBuilder) InitialValue(value string) *EmailInputBuilder {
+	e.input.InitialValue = value
+	return e
+}
+
+// Build returns the email input.
+func (e *EmailInputBuilder) Build() *slack.EmailTextInputBlockElement {
+	return e.input
+}
+
+// URLInputBuilder builds a URL input.
+type URLInputBuilder struct {
+	input *slack.URLTextInputBlockElement
+}
+
+// NewURLInput initializes a URLInputBuilder.
+func NewURLInput(actionID string) *URLInputBuilder {
+	return &URLInputBuilder{
+		input: slack.NewURLTextInputBlockElement(nil, actionID),
+	}
+}
+
+// Placeholder sets the text shown while the input is empty.
+func (u *URLInputBuilder) Placeholder(text string) *URLInputBuilder {
+	u.input.Placeholder = slack.NewTextBlockObject("plain_text", text, true, false)
+	return u
+}
+
+// InitialValue prefills the input.
+func (u *URLInputBuilder) InitialValue(value string) *URLInputBuilder {
+	u.input.InitialValue = value
+	return u
+}
+
+// Build returns the URL input.
+func (u *URLInputBuilder) Build() *slack.URLTextInputBlockElement {
+	return u.input
+}
+
+// formatNumber renders value without a trailing fractional part for whole numbers.
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,22 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// NewOption creates an option with plain text for selects, overflow menus, checkboxes and radio buttons.
+func NewOption(value, text string) *slack.OptionBlockObject {
+	return slack.NewOptionBlockObject(value, slack.NewTextBlockObject("plain_text", text, true, false), nil)
+}
+
+// NewOptionWithDescription creates an option with a line of plain text description below its text.
+func NewOptionWithDescription(value, text, description string) *slack.OptionBlockObject {
+	return slack.NewOptionBlockObject(value,
+		slack.NewTextBlockObject("plain_text", text, true, false),
+		slack.NewTextBlockObject("plain_text", description, true, false))
+}
+
+// NewOptionGroup creates a labeled group of options for static and external selects.
+func NewOptionGroup(label string, options ...*slack.OptionBlockObject) *slack.OptionGroupBlockObject {
+	return slack.NewOptionGroupBlockElement(slack.NewTextBlockObject("plain_text", label, true, false), options...)
+}
@@ -0,0 +1,106 @@
+package blockbuilder
+
+import (
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// DatePickerBuilder builds a date picker.
+type DatePickerBuilder struct {
+	picker *slack.DatePickerBlockElement
+}
+
+// NewDatePicker initializes a DatePickerBuilder.
+func NewDatePicker(actionID string) *DatePickerBuilder {
+	return &DatePickerBuilder{
+		picker: slack.NewDatePickerBlockElement(actionID),
+	}
+}
+
+// Placeholder sets the text shown before a date is picked.
+func (d *DatePickerBuilder) Placeholder(text string) *DatePickerBuilder {
+	d.picker.Placeholder = slack.NewTextBlockObject("plain_text", text, true, false)
+	return d
+}
+
+// InitialDate preselects the calendar date of t.
+func (d *DatePickerBuilder) InitialDate(t time.Time) *DatePickerBuilder {
+	d.picker.InitialDate = t.Format(time.DateOnly)
+	return d
+}
+
+// Confirm shows a confirmation dialog after a date is picked.
+func (d *DatePickerBuilder) Confirm(confirm *slack.ConfirmationBlockObject) *DatePickerBuilder {
+	d.picker.Confirm = confirm
+	return d
+}
+
+// Build returns the date picker.
+func (d *DatePickerBuilder) Build() *slack.DatePickerBlockElement {
+	return d.picker
+}
+
+// TimePickerBuilder builds a time picker.
+type TimePickerBuilder struct {
+	picker *slack.TimePickerBlockElement
+}
+
+// NewTimePicker initializes a TimePickerBuilder.
+func NewTimePicker(actionID string) *TimePickerBuilder {
+	return &TimePickerBuilder{
+		picker: slack.NewTimePickerBlockElement(actionID),
+	}
+}
+
+// Placeholder sets the text shown before a time is picked.
+func (p *TimePickerBuilder) Placeholder(text string) *TimePickerBuilder {
+	p.picker.Placeholder = slack.NewTextBlockObject("plain_text", text, true, false)
+	return p
+}
+
+// InitialTime preselects the hour and minute of t.
+func (p *TimePickerBuilder) InitialTime(t time.Time) *TimePickerBuilder {
+	p.picker.InitialTime = t.Format("15:04")
+	return p
+}
+
+// Confirm shows a confirmation dialog after a time is picked.
+func (p *TimePickerBuilder) Confirm(confirm *slack.ConfirmationBlockObject) *TimePickerBuilder {
+	p.picker.Confirm = confirm
+	return p
+}
+
+// Build returns the time picker.
+func (p *TimePickerBuilder) Build() *slack.TimePickerBlockElement {
+	return p.picker
+}
+
+// DateTimePickerBuilder builds a combined date and time picker.
+type DateTimePickerBuilder struct {
+	picker *slack.DateTimePickerBlockElement
+}
+
+// NewDateTimePicker initializes a DateTimePickerBuilder.
+func NewDateTimePicker(actionID string) *DateTimePickerBuilder {
+	return &DateTimePickerBuilder{
+		picker: slack.NewDateTimePickerBlockElement(actionID),
+	}
+}
+
+// InitialDateTime preselects t.
+func (p *DateTimePickerBuilder) InitialDateTime(t time.Time) *DateTimePickerBuilder {
+	p.picker.InitialDateTime = t.Unix()
+	return p
+}
+
+// Confirm shows a confirmation dialog after a date and time are picked.
+func (p *DateTimePickerBuilder) Confirm(confirm *slack.ConfirmationBlockObject) *DateTimePickerBuilder {
+	p.picker.Confirm = confirm
+	return p
+}
+
+// Build returns the date and time picker.
+func (p *DateTimePickerBuilder) Build() *slack.DateTimePickerBlockElement {
+	return p.picker
+}
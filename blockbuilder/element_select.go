@@ -0,0 +1,154 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// SelectBuilder builds a single or multi select menu.
+type SelectBuilder struct {
+	optType              string
+	placeholder          string
+	actionID             string
+	multi                bool
+	options              []*slack.OptionBlockObject
+	optionGroups         []*slack.OptionGroupBlockObject
+	initialOptions       []*slack.OptionBlockObject
+	initialUsers         []string
+	initialConversations []string
+	initialChannels      []string
+	minQueryLength       *int
+	maxSelectedItems     *int
+	confirm              *slack.ConfirmationBlockObject
+}
+
+// NewStaticSelect initializes a SelectBuilder for a menu of fixed options.
+func NewStaticSelect(actionID, placeholder string, options ...*slack.OptionBlockObject) *SelectBuilder {
+	return &SelectBuilder{optType: slack.OptTypeStatic, actionID: actionID, placeholder: placeholder, options: options}
+}
+
+// NewExternalSelect initializes a SelectBuilder for a menu whose options are loaded from the app's options URL.
+func NewExternalSelect(actionID, placeholder string) *SelectBuilder {
+	return &SelectBuilder{optType: slack.OptTypeExternal, actionID: actionID, placeholder: placeholder}
+}
+
+// NewUsersSelect initializes a SelectBuilder for a menu of workspace users.
+func NewUsersSelect(actionID, placeholder string) *SelectBuilder {
+	return &SelectBuilder{optType: slack.OptTypeUser, actionID: actionID, placeholder: placeholder}
+}
+
+// NewConversationsSelect initializes a SelectBuilder for a menu of conversations.
+func NewConversationsSelect(actionID, placeholder string) *SelectBuilder {
+	return &SelectBuilder{optType: slack.OptTypeConversations, actionID: actionID, placeholder: placeholder}
+}
+
+// NewChannelsSelect initializes a SelectBuilder for a menu of public channels.
+func NewChannelsSelect(actionID, placeholder string) *SelectBuilder {
+	return &SelectBuilder{optType: slack.OptTypeChannels, actionID: actionID, placeholder: placeholder}
+}
+
+// Multi lets the user pick several items.
+func (s *SelectBuilder) Multi() *SelectBuilder {
+	s.multi = true
+	return s
+}
+
+// MaxSelectedItems limits how many items can be picked. It implies Multi.
+func (s *SelectBuilder) MaxSelectedItems(n int) *SelectBuilder {
+	s.multi = true
+	s.maxSelectedItems = &n
+	return s
+}
+
+// OptionGroups sets labeled groups of options, replacing any plain options.
+func (s *SelectBuilder) OptionGroups(groups ...*slack.OptionGroupBlockObject) *SelectBuilder {
+	s.options = nil
+	s.optionGroups = groups
+	return s
+}
+
+// MinQueryLength sets how many characters the user types before an external select loads options.
+func (s *SelectBuilder) MinQueryLength(n int) *SelectBuilder {
+	s.minQueryLength = &n
+	return s
+}
+
+// InitialOptions preselects options in a static or external select.
+// A single select uses only the first option.
+func (s *SelectBuilder) InitialOptions(options ...*slack.OptionBlockObject) *SelectBuilder {
+	s.initialOptions = options
+	return s
+}
+
+// InitialUsers preselects users in a users select. A single select uses only the first user.
+func (s *SelectBuilder) InitialUsers(userIDs ...string) *SelectBuilder {
+	s.initialUsers = userIDs
+	return s
+}
+
+// InitialConversations preselects conversations in a conversations select.
+// A single select uses only the first conversation.
+func (s *SelectBuilder) InitialConversations(conversationIDs ...string) *SelectBuilder {
+	s.initialConversations = conversationIDs
+	return s
+}
+
+// InitialChannels preselects channels in a channels select. A single select uses only the first channel.
+func (s *SelectBuilder) InitialChannels(channelIDs ...string) *SelectBuilder {
+	s.initialChannels = channelIDs
+	return s
+}
+
+// Confirm shows a confirmation dialog after a selection is made.
+func (s *SelectBuilder) Confirm(confirm *slack.ConfirmationBlockObject) *SelectBuilder {
+	s.confirm = confirm
+	return s
+}
+
+// Build returns the menu: a *slack.SelectBlockElement, or a *slack.MultiSelectBlockElement
+// when Multi or MaxSelectedItems was used.
+func (s *SelectBuilder) Build() slack.BlockElement {
+	var placeholder *slack.TextBlockObject
+	if s.placeholder != "" {
+		placeholder = slack.NewTextBlockObject("plain_text", s.placeholder, true, false)
+	}
+
+	if s.multi {
+		return &slack.MultiSelectBlockElement{
+			Type:                 "multi_" + s.optType,
+			Placeholder:          placeholder,
+			ActionID:             s.actionID,
+			Options:              s.options,
+			OptionGroups:         s.optionGroups,
+			InitialOptions:       s.initialOptions,
+			InitialUsers:         s.initialUsers,
+			InitialConversations: s.initialConversations,
+			InitialChannels:      s.initialChannels,
+			MinQueryLength:       s.minQueryLength,
+			MaxSelectedItems:     s.maxSelectedItems,
+			Confirm:              s.confirm,
+		}
+	}
+
+	return &slack.SelectBlockElement{
+		Type:                s.optType,
+		Placeholder:         placeholder,
+		ActionID:            s.actionID,
+		Options:             s.options,
+		OptionGroups:        s.optionGroups,
+		InitialOption:       first(s.initialOptions),
+		InitialUser:         first(s.initialUsers),
+		InitialConversation: first(s.initialConversations),
+		InitialChannel:      first(s.initialChannels),
+		MinQueryLength:      s.minQueryLength,
+		Confirm:             s.confirm,
+	}
+}
+
+// first returns the first item, or the zero value if there are none.
+func first[T any](items []T) T {
+	var zero T
+	if len(items) == 0 {
+		return zero
+	}
+	return items[0]
+}
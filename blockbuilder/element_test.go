@@ -0,0 +1,85 @@
+package blockbuilder_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestButtonBuilder(t *testing.T) {
+	button := blockbuilder.NewButtonBuilder("delete", "Delete").
+		Value("incident-42").
+		Danger().
+		Build().(*slack.ButtonBlockElement)
+
+	if button.Style != slack.StyleDanger || button.Value != "incident-42" {
+		t.Errorf("unexpected button: %+v", button)
+	}
+
+	accessible := blockbuilder.NewButtonBuilder("open", "Open").
+		URL("https://example.com").
+		AccessibilityLabel("Open the runbook").
+		Build()
+	data, err := json.Marshal(accessible)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	for _, want := range []string{`"accessibility_label":"Open the runbook"`, `"url":"https://example.com"`, `"type":"button"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected %s in %s", want, data)
+		}
+	}
+	if inner, ok := blockbuilder.ButtonOf(accessible); !ok || inner.URL != "https://example.com" {
+		t.Errorf("ButtonOf(accessible) = %+v, %v", inner, ok)
+	}
+	if inner, ok := blockbuilder.ButtonOf(button); !ok || inner != button {
+		t.Errorf("ButtonOf(button) = %+v, %v", inner, ok)
+	}
+	if _, ok := blockbuilder.ButtonOf(blockbuilder.NewDatePicker("due").Build()); ok {
+		t.Errorf("ButtonOf reported a button for a date picker")
+	}
+}
+
+func TestSelectBuilder(t *testing.T) {
+	low := blockbuilder.NewOption("low", "Low")
+	high := blockbuilder.NewOption("high", "High")
+
+	single := blockbuilder.NewStaticSelect("severity", "Severity").
+		OptionGroups(blockbuilder.NewOptionGroup("Levels", low, high)).
+		InitialOptions(high).
+		Build().(*slack.SelectBlockElement)
+	if single.Type != slack.OptTypeStatic || single.InitialOption != high || len(single.OptionGroups) != 1 {
+		t.Errorf("unexpected static select: %+v", single)
+	}
+
+	multi := blockbuilder.NewUsersSelect("owners", "Owners").
+		MaxSelectedItems(3).
+		InitialUsers("U1", "U2").
+		Build().(*slack.MultiSelectBlockElement)
+	if multi.Type != slack.MultiOptTypeUser || *multi.MaxSelectedItems != 3 || len(multi.InitialUsers) != 2 {
+		t.Errorf("unexpected users multi select: %+v", multi)
+	}
+}
+
+func TestPickersAndInputs(t *testing.T) {
+	at := time.Date(2024, 3, 9, 14, 5, 0, 0, time.UTC)
+
+	if d := blockbuilder.NewDatePicker("due").InitialDate(at).Build(); d.InitialDate != "2024-03-09" {
+		t.Errorf("unexpected initial date %q", d.InitialDate)
+	}
+	if p := blockbuilder.NewTimePicker("at").InitialTime(at).Build(); p.InitialTime != "14:05" {
+		t.Errorf("unexpected initial time %q", p.InitialTime)
+	}
+	if p := blockbuilder.NewDateTimePicker("when").InitialDateTime(at).Build(); p.InitialDateTime != at.Unix() {
+		t.Errorf("unexpected initial date time %d", p.InitialDateTime)
+	}
+
+	n := blockbuilder.NewNumberInput("replicas").Range(1, 10).InitialValue(3).Build()
+	if n.MinValue != "1" || n.MaxValue != "10" || n.InitialValue != "3" || n.IsDecimalAllowed {
+		t.Errorf("unexpected number input: %+v", n)
+	}
+}
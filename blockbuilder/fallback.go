@@ -0,0 +1,187 @@
+package blockbuilder
+
+import (
+	"strings"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/mrkdwn"
+	"github.com/slack-go/slack"
+)
+
+// DefaultFallbackLength is the length of fallback text when no limit is given, about what
+// a notification shows.
+const DefaultFallbackLength = 150
+
+// FallbackText derives a one-line summary of blocks for a message's top-level text, which
+// Slack shows in notifications and reads to screen readers. It combines the first header
+// with the first section or rich text, falling back to context text and image alt text.
+// The summary is escaped mrkdwn, so mentions in the blocks' text are shown rather than
+// triggered, and is truncated to maxLength characters (DefaultFallbackLength if 0).
+func FallbackText(blocks []slack.Block, maxLength int) string {
+	return finishFallback(blocksSummary(blocks), maxLength)
+}
+
+// MessageFallbackText is like FallbackText, using attachments when blocks yield no text.
+func MessageFallbackText(blocks []slack.Block, attachments []slack.Attachment, maxLength int) string {
+	summary := blocksSummary(blocks)
+	for _, attachment := range attachments {
+		if summary != "" {
+			break
+		}
+		summary = attachmentSummary(attachment)
+	}
+	return finishFallback(summary, maxLength)
+}
+
+// FallbackText derives an escaped summary of the builder's blocks. See FallbackText.
+func (b *BlockBuilder) FallbackText(maxLength int) string {
+	return FallbackText(b.blocks, maxLength)
+}
+
+// FallbackText derives an escaped summary of the attachment, preferring its Fallback field.
+func (a *AttachmentBuilder) FallbackText(maxLength int) string {
+	return finishFallback(attachmentSummary(a.attachment), maxLength)
+}
+
+// finishFallback collapses whitespace in summary, escapes it and truncates it.
+func finishFallback(summary string, maxLength int) string {
+	if maxLength <= 0 {
+		maxLength = DefaultFallbackLength
+	}
+	return escapeTruncate(strings.Join(strings.Fields(summary), " "), maxLength)
+}
+
+// attachmentSummary summarizes an attachment from its fallback, blocks or legacy fields.
+func attachmentSummary(attachment slack.Attachment) string {
+	if attachment.Fallback != "" {
+		return attachment.Fallback
+	}
+	if summary := blocksSummary(attachment.Blocks.BlockSet); summary != "" {
+		return summary
+	}
+	return joinNonEmpty(": ", attachment.Title, mrkdwn.ToPlainText(attachment.Text), mrkdwn.ToPlainText(attachment.Pretext))
+}
+
+// blocksSummary returns the first header joined with the first body text, or the first
+// context or image text if there is neither.
+func blocksSummary(blocks []slack.Block) string {
+	var header, body, extra string
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case *slack.HeaderBlock:
+			if header == "" {
+				header = plainText(b.Text)
+			}
+		case *slack.SectionBlock, *AccessorySection:
+			if section, _ := SectionOf(b); body == "" {
+				body = sectionText(section)
+			}
+		case *slack.RichTextBlock:
+			if body == "" {
+				body = richTextPlain(b.Elements)
+			}
+		case *slack.ContextBlock:
+			if extra == "" {
+				var parts []string
+				for _, element := range b.ContextElements.Elements {
+					if text, ok := element.(*slack.TextBlockObject); ok {
+						parts = append(parts, plainText(text))
+					}
+				}
+				extra = joinNonEmpty(" ", parts...)
+			}
+		case *slack.ImageBlock:
+			if extra == "" {
+				extra = b.AltText
+			}
+		}
+	}
+	if header == "" && body == "" {
+		return extra
+	}
+	return joinNonEmpty(": ", header, body)
+}
+
+// sectionText returns the text of a section, or its fields if it has none.
+func sectionText(section *slack.SectionBlock) string {
+	if text := plainText(section.Text); text != "" {
+		return text
+	}
+	fields := make([]string, 0, len(section.Fields))
+	for _, field := range section.Fields {
+		fields = append(fields, plainText(field))
+	}
+	return joinNonEmpty(" | ", fields...)
+}
+
+// plainText returns the text of a text object, converting mrkdwn to plain text.
+func plainText(text *slack.TextBlockObject) string {
+	if text == nil {
+		return ""
+	}
+	if text.Type == slack.MarkdownType {
+		return mrkdwn.ToPlainText(text.Text)
+	}
+	return text.Text
+}
+
+// richTextPlain returns the text of rich text elements.
+func richTextPlain(elements []slack.RichTextElement) string {
+	parts := make([]string, 0, len(elements))
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *slack.RichTextSection:
+			parts = append(parts, richTextSectionPlain(e.Elements))
+		case *slack.RichTextQuote:
+			parts = append(parts, richTextSectionPlain(e.Elements))
+		case *slack.RichTextPreformatted:
+			parts = append(parts, richTextSectionPlain(e.Elements))
+		case *slack.RichTextList:
+			parts = append(parts, richTextPlain(e.Elements))
+		}
+	}
+	return joinNonEmpty(" ", parts...)
+}
+
+// richTextSectionPlain returns the text of rich text section elements, showing mentions
+// by ID.
+func richTextSectionPlain(elements []slack.RichTextSectionElement) string {
+	var b strings.Builder
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *slack.RichTextSectionTextElement:
+			b.WriteString(e.Text)
+		case *slack.RichTextSectionLinkElement:
+			if e.Text != "" {
+				b.WriteString(e.Text)
+			} else {
+				b.WriteString(e.URL)
+			}
+		case *slack.RichTextSectionUserElement:
+			b.WriteString("@" + e.UserID)
+		case *slack.RichTextSectionChannelElement:
+			b.WriteString("#" + e.ChannelID)
+		case *slack.RichTextSectionUserGroupElement:
+			b.WriteString("@" + e.UsergroupID)
+		case *slack.RichTextSectionBroadcastElement:
+			b.WriteString("@" + e.Range)
+		case *slack.RichTextSectionEmojiElement:
+			b.WriteString(":" + e.Name + ":")
+		case *slack.RichTextSectionDateElement:
+			if e.Fallback != nil {
+				b.WriteString(*e.Fallback)
+			}
+		}
+	}
+	return b.String()
+}
+
+// joinNonEmpty joins the non-empty parts with sep.
+func joinNonEmpty(sep string, parts ...string) string {
+	nonEmpty := parts[:0:0]
+	for _, part := range parts {
+		if strings.TrimSpace(part) != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, sep)
+}
@@ -0,0 +1,113 @@
+package blockbuilder_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestFallbackTextPrefersHeaderAndFirstSection(t *testing.T) {
+	blocks := blockbuilder.NewBlockBuilder().
+		AddImage("https://example.com/a.png", "chart").
+		AddHeader("Deploy finished").
+		AddSection("*api* v1.2 deployed by <@U1>\nsee <https://x.com|logs>", true).
+		AddSection("second section", false)
+
+	got := blocks.FallbackText(0)
+	want := "Deploy finished: api v1.2 deployed by @U1 see logs (https://x.com)"
+	if got != want {
+		t.Errorf("FallbackText = %q, want %q", got, want)
+	}
+}
+
+func TestFallbackTextSources(t *testing.T) {
+	tests := []struct {
+		name   string
+		blocks []slack.Block
+		want   string
+	}{
+		{
+			name:   "fields",
+			blocks: blockbuilder.NewBlockBuilder().AddFields(blockbuilder.Field{Label: "Status", Value: "ok"}).Build(),
+			want:   "Status ok",
+		},
+		{
+			name: "rich text",
+			blocks: blockbuilder.NewBlockBuilder().AddRichTextFrom(blockbuilder.NewRichText().
+				Paragraph(blockbuilder.RichTextBold("Alert"), blockbuilder.RichTextSpan(" for "), blockbuilder.RichTextUser("U1")).
+				BulletList(blockbuilder.RichTextItem(blockbuilder.RichTextSpan("one")), blockbuilder.RichTextItem(blockbuilder.RichTextSpan("two")))).Build(),
+			want: "Alert for @U1 one two",
+		},
+		{
+			name: "context",
+			blocks: blockbuilder.NewBlockBuilder().
+				AddContext(slack.NewTextBlockObject(slack.MarkdownType, "_updated_ now", false, false)).
+				AddImage("https://example.com/a.png", "chart").Build(),
+			want: "updated now",
+		},
+		{
+			name:   "image",
+			blocks: blockbuilder.NewBlockBuilder().AddDivider().AddImage("https://example.com/a.png", "chart").Build(),
+			want:   "chart",
+		},
+		{
+			name:   "empty",
+			blocks: nil,
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		if got := blockbuilder.FallbackText(tt.blocks, 0); got != tt.want {
+			t.Errorf("%s: FallbackText = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFallbackTextStaysEscaped(t *testing.T) {
+	blocks := blockbuilder.NewBlockBuilder().
+		AddSection("user said &lt;!channel&gt; &amp; left", true).
+		AddSection(strings.Repeat("<", 40), false).Build()
+
+	if got, want := blockbuilder.FallbackText(blocks[:1], 0), "user said &lt;!channel&gt; &amp; left"; got != want {
+		t.Errorf("FallbackText = %q, want %q", got, want)
+	}
+	got := blockbuilder.FallbackText(blocks[1:], 18)
+	if got != strings.Repeat("&lt;", 4)+"…" {
+		t.Errorf("expected truncation between entities, got %q", got)
+	}
+}
+
+func TestFallbackTextTruncates(t *testing.T) {
+	blocks := blockbuilder.NewBlockBuilder().AddSection(strings.Repeat("word ", 100), false).Build()
+
+	got := blockbuilder.FallbackText(blocks, 20)
+	if utf8.RuneCountInString(got) != 20 || !strings.HasSuffix(got, "…") {
+		t.Errorf("FallbackText(20) = %q", got)
+	}
+	if got := blockbuilder.FallbackText(blocks, 0); utf8.RuneCountInString(got) != blockbuilder.DefaultFallbackLength {
+		t.Errorf("default length = %d", utf8.RuneCountInString(got))
+	}
+}
+
+func TestMessageFallbackTextUsesAttachments(t *testing.T) {
+	attachment := blockbuilder.NewAttachmentBuilder(blockbuilder.ColorDanger).
+		AddHeader("Disk full").
+		AddSection("on db-1", false)
+
+	if got, want := attachment.FallbackText(0), "Disk full: on db-1"; got != want {
+		t.Errorf("AttachmentBuilder.FallbackText = %q, want %q", got, want)
+	}
+
+	got := blockbuilder.MessageFallbackText(nil, []slack.Attachment{{Fallback: "explicit"}, attachment.Build()}, 0)
+	if got != "explicit" {
+		t.Errorf("MessageFallbackText = %q, want %q", got, "explicit")
+	}
+
+	got = blockbuilder.MessageFallbackText(nil, []slack.Attachment{{Title: "Title", Text: "*body*"}}, 0)
+	if got != "Title: body" {
+		t.Errorf("MessageFallbackText legacy = %q, want %q", got, "Title: body")
+	}
+}
@@ -0,0 +1,558 @@
+package blockbuilder
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/slack-go/slack"
+)
+
+// formTag is the struct tag read by FormBlocks and DecodeForm.
+//
+// The tag is a comma-separated list of key=value pairs and flags:
+//
+//	id=<block and action ID>    defaults to the field name in snake_case
+//	label=<label>               defaults to the field name
+//	type=<element type>         defaults from the field's Go type, see FormBlocks
+//	options=<a|b|value:Text>    options for selects, checkboxes and radio buttons
+//	placeholder=<text>          placeholder for text inputs, selects and pickers
+//	hint=<text>                 hint shown below the input
+//	required                    the input must be filled in; inputs are optional otherwise
+//	multiline                   a multi-line plain text input
+//	minlength=<n>, maxlength=<n> length limits checked by FormValidator and set on text inputs
+//	min=<n>, max=<n>            number range checked by FormValidator and set on number inputs
+//	pattern=<regexp>            pattern checked by FormValidator; it cannot contain commas
+//	future                      dates must be after today in time.Local, or datetimes after now,
+//	                            checked by FormValidator
+//
+// Fields tagged slack:"-" or without a slack tag are ignored.
+const formTag = "slack"
+
+// timeType is used to recognize time.Time fields.
+var timeType = reflect.TypeOf(time.Time{})
+
+// formField describes one struct field of a form.
+type formField struct {
+	index       []int
+	id          string
+	label       string
+	elemType    string
+	options     []*slack.OptionBlockObject
+	placeholder string
+	hint        string
+	required    bool
+	multiline   bool
+	future      bool
+	minLength   int
+	maxLength   int
+	minValue    *float64
+	maxValue    *float64
+	validators  []InputValidator
+}
+
+// FormBlocks generates one input block per tagged field of form, a struct or pointer to struct.
+// Non-zero field values become the inputs' initial values.
+//
+// Without a type key, string fields use plain_text_input, integer and float fields number_input,
+// bool fields a single checkbox, []string fields multi_static_select and time.Time fields datepicker.
+// The type key accepts plain_text_input, number_input, email_text_input, url_text_input,
+// static_select, external_select, users_select, conversations_select, channels_select, their
+// multi_ variants, checkboxes, radio_buttons, datepicker, timepicker and datetimepicker.
+// Static selects, radio buttons and checkboxes other than a bool's need the options key,
+// and time.Time fields shown as a timepicker hold the time of day as "15:04".
+func FormBlocks(form any) ([]slack.Block, error) {
+	v := reflect.Indirect(reflect.ValueOf(form))
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form must be a struct or pointer to struct, got %T", form)
+	}
+	fields, err := parseForm(v.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]slack.Block, 0, len(fields))
+	for _, f := range fields {
+		element, err := f.element(v.FieldByIndex(f.index))
+		if err != nil {
+			return nil, err
+		}
+		input := NewInputBlock(f.id, f.label, element)
+		input.Optional = !f.required
+		if f.hint != "" {
+			input.Hint = slack.NewTextBlockObject("plain_text", f.hint, true, false)
+		}
+		blocks = append(blocks, input)
+	}
+	return blocks, nil
+}
+
+// DecodeForm copies the values submitted in a view's state into the tagged fields of form,
+// which must be a pointer to the struct passed to FormBlocks. Inputs left empty leave their
+// fields unchanged, except that a bool field whose checkbox is left unchecked is set to false.
+func DecodeForm(state *slack.ViewState, form any) error {
+	v := reflect.ValueOf(form)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("form must be a pointer to struct, got %T", form)
+	}
+	if state == nil {
+		return errors.New("view has no state")
+	}
+	v = v.Elem()
+	fields, err := parseForm(v.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		action, ok := state.Values[f.id][f.id]
+		if !ok {
+			continue
+		}
+		values := submittedValues(action)
+		if len(values) == 0 {
+			if field := v.FieldByIndex(f.index); field.Kind() == reflect.Bool {
+				field.SetBool(false)
+			}
+			continue
+		}
+		if err := f.set(v.FieldByIndex(f.index), values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeSubmission decodes a view_submission callback's state into form. See DecodeForm.
+func DecodeSubmission(callback slack.InteractionCallback, form any) error {
+	return DecodeForm(callback.View.State, form)
+}
+
+// FormValidator returns a SubmissionValidator enforcing the required, minlength, maxlength,
+// min, max, pattern and future tags of form's fields.
+func FormValidator(form any) (*SubmissionValidator, error) {
+	t := reflect.TypeOf(form)
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form must be a struct or pointer to struct, got %T", form)
+	}
+	fields, err := parseForm(t)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewSubmissionValidator()
+	for _, f := range fields {
+		if len(f.validators) > 0 {
+			s.Input(f.id, f.validators...)
+		}
+	}
+	return s, nil
+}
+
+// Form appends input blocks generated from form to the modal's body and registers the
+// validators its tags declare with SubmissionValidator. See FormBlocks and FormValidator.
+// Errors are reported by Validate.
+func (v *ViewBuilder) Form(form any) *ViewBuilder {
+	blocks, err := FormBlocks(form)
+	if err != nil {
+		v.formErr = err
+		return v
+	}
+	validator, err := FormValidator(form)
+	if err != nil {
+		v.formErr = err
+		return v
+	}
+	v.blocks.blocks = append(v.blocks.blocks, blocks...)
+	for _, blockID := range validator.blockIDs {
+		v.submission.Input(blockID, validator.validators[blockID]...)
+	}
+	return v
+}
+
+// parseForm reads the tagged fields of a struct type.
+func parseForm(t reflect.Type) ([]formField, error) {
+	var fields []formField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup(formTag)
+		if !ok || tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		f := formField{index: sf.Index, id: snakeCase(sf.Name), label: sf.Name}
+		for _, part := range strings.Split(tag, ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+			switch key {
+			case "id":
+				f.id = value
+			case "label":
+				f.label = value
+			case "type":
+				f.elemType = value
+			case "options":
+				for _, opt := range strings.Split(value, "|") {
+					val, text, found := strings.Cut(opt, ":")
+					if !found {
+						text = val
+					}
+					f.options = append(f.options, NewOption(val, text))
+				}
+			case "placeholder":
+				f.placeholder = value
+			case "hint":
+				f.hint = value
+			case "required":
+				f.required = true
+			case "multiline":
+				f.multiline = true
+			case "minlength", "maxlength":
+				n, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("field %s: invalid %s: %w", sf.Name, key, err)
+				}
+				if key == "minlength" {
+					f.minLength = n
+				} else {
+					f.maxLength = n
+				}
+			case "min", "max":
+				n, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("field %s: invalid %s: %w", sf.Name, key, err)
+				}
+				if key == "min" {
+					f.minValue = &n
+				} else {
+					f.maxValue = &n
+				}
+			case "pattern":
+				re, err := regexp.Compile(value)
+				if err != nil {
+					return nil, fmt.Errorf("field %s: invalid pattern: %w", sf.Name, err)
+				}
+				f.validators = append(f.validators, MatchPattern(re, fmt.Sprintf("Must match %s.", value)))
+			case "future":
+				f.future = true
+			case "":
+			default:
+				return nil, fmt.Errorf("field %s: unknown slack tag key %q", sf.Name, key)
+			}
+		}
+
+		if f.elemType == "" {
+			f.elemType = defaultElementType(sf.Type)
+		}
+		if f.future {
+			if f.elemType == string(slack.METDatetimepicker) {
+				f.validators = append(f.validators, FutureDateTime(time.Now))
+			} else {
+				f.validators = append(f.validators, FutureDate(time.Now, time.Local))
+			}
+		}
+		if f.minLength > 0 || f.maxLength > 0 {
+			f.validators = append(f.validators, Length(f.minLength, f.maxLength))
+		}
+		if f.minValue != nil || f.maxValue != nil {
+			f.validators = append(f.validators, NumberRange(derefOr(f.minValue, math.Inf(-1)), derefOr(f.maxValue, math.Inf(1))))
+		}
+		if f.required {
+			f.validators = append([]InputValidator{Required()}, f.validators...)
+		}
+		if f.elemType == "" {
+			return nil, fmt.Errorf("field %s: no default element type for %s", sf.Name, sf.Type)
+		}
+		if len(f.options) == 0 && f.needsOptions(sf.Type) {
+			return nil, fmt.Errorf("field %s: %s needs options", sf.Name, f.elemType)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// defaultElementType picks the element type for a field without a type key.
+func defaultElementType(t reflect.Type) string {
+	if t == timeType {
+		return string(slack.METDatepicker)
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return string(slack.METPlainTextInput)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return string(slack.METNumber)
+	case reflect.Bool:
+		return string(slack.METCheckboxGroups)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.String {
+			return slack.MultiOptTypeStatic
+		}
+	}
+	return ""
+}
+
+// needsOptions reports whether the field's element lists fixed options, which a bool
+// checkbox field provides itself.
+func (f formField) needsOptions(t reflect.Type) bool {
+	switch f.elemType {
+	case string(slack.METCheckboxGroups):
+		return t.Kind() != reflect.Bool
+	case string(slack.METRadioButtons), slack.OptTypeStatic, slack.MultiOptTypeStatic:
+		return true
+	}
+	return false
+}
+
+// timeLayout returns the layout of the values the field's picker sends for time.Time fields.
+func (f formField) timeLayout() string {
+	if f.elemType == string(slack.METTimepicker) {
+		return "15:04"
+	}
+	return time.DateOnly
+}
+
+// element creates the input element for the field, using value as its initial value.
+func (f formField) element(value reflect.Value) (slack.BlockElement, error) {
+	initial := formValues(value, f.timeLayout())
+
+	switch f.elemType {
+	case string(slack.METPlainTextInput):
+		b := NewPlainTextInput(f.id)
+		if f.placeholder != "" {
+			b.Placeholder(f.placeholder)
+		}
+		if f.multiline {
+			b.Multiline()
+		}
+		b.Length(f.minLength, f.maxLength)
+		return b.InitialValue(first(initial)).Build(), nil
+	case string(slack.METNumber):
+		input := NewNumberInput(f.id).Build()
+		input.IsDecimalAllowed = value.Kind() == reflect.Float32 || value.Kind() == reflect.Float64
+		input.InitialValue = first(initial)
+		if f.minValue != nil {
+			input.MinValue = formatNumber(*f.minValue)
+		}
+		if f.maxValue != nil {
+			input.MaxValue = formatNumber(*f.maxValue)
+		}
+		if f.placeholder != "" {
+			input.Placeholder = slack.NewTextBlockObject("plain_text", f.placeholder, true, false)
+		}
+		return input, nil
+	case string(slack.METEmailTextInput):
+		b := NewEmailInput(f.id).InitialValue(first(initial))
+		if f.placeholder != "" {
+			b.Placeholder(f.placeholder)
+		}
+		return b.Build(), nil
+	case string(slack.METURLTextInput):
+		b := NewURLInput(f.id).InitialValue(first(initial))
+		if f.placeholder != "" {
+			b.Placeholder(f.placeholder)
+		}
+		return b.Build(), nil
+	case string(slack.METCheckboxGroups):
+		options := f.options
+		if len(options) == 0 && value.Kind() == reflect.Bool {
+			options = []*slack.OptionBlockObject{NewOption("true", f.label)}
+		}
+		b := NewCheckboxes(f.id, options...)
+		if selected := f.selectedOptions(options, initial); len(selected) > 0 {
+			b.InitialOptions(selected...)
+		}
+		return b.Build(), nil
+	case string(slack.METRadioButtons):
+		b := NewRadioButtons(f.id, f.options...)
+		if selected := f.selectedOptions(f.options, initial); len(selected) > 0 {
+			b.InitialOption(selected[0])
+		}
+		return b.Build(), nil
+	case string(slack.METDatepicker):
+		b := NewDatePicker(f.id)
+		if f.placeholder != "" {
+			b.Placeholder(f.placeholder)
+		}
+		picker := b.Build()
+		picker.InitialDate = first(initial)
+		return picker, nil
+	case string(slack.METTimepicker):
+		b := NewTimePicker(f.id)
+		if f.placeholder != "" {
+			b.Placeholder(f.placeholder)
+		}
+		picker := b.Build()
+		picker.InitialTime = first(initial)
+		return picker, nil
+	case string(slack.METDatetimepicker):
+		b := NewDateTimePicker(f.id)
+		if t, ok := value.Interface().(time.Time); ok && !t.IsZero() {
+			b.InitialDateTime(t)
+		}
+		return b.Build(), nil
+	}
+
+	// Select menus
+	optType, multi := strings.CutPrefix(f.elemType, "multi_")
+	var b *SelectBuilder
+	switch optType {
+	case slack.OptTypeStatic:
+		b = NewStaticSelect(f.id, f.placeholder, f.options...)
+		b.InitialOptions(f.selectedOptions(f.options, initial)...)
+	case slack.OptTypeExternal:
+		b = NewExternalSelect(f.id, f.placeholder)
+	case slack.OptTypeUser:
+		b = NewUsersSelect(f.id, f.placeholder).InitialUsers(initial...)
+	case slack.OptTypeConversations:
+		b = NewConversationsSelect(f.id, f.placeholder).InitialConversations(initial...)
+	case slack.OptTypeChannels:
+		b = NewChannelsSelect(f.id, f.placeholder).InitialChannels(initial...)
+	default:
+		return nil, fmt.Errorf("field %s: unsupported element type %q", f.id, f.elemType)
+	}
+	if multi {
+		b.Multi()
+	}
+	return b.Build(), nil
+}
+
+// selectedOptions returns the options whose values are in values.
+func (f formField) selectedOptions(options []*slack.OptionBlockObject, values []string) []*slack.OptionBlockObject {
+	var selected []*slack.OptionBlockObject
+	for _, opt := range options {
+		for _, value := range values {
+			if opt.Value == value {
+				selected = append(selected, opt)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// set converts submitted values to the field's Go type and stores them.
+func (f formField) set(field reflect.Value, values []string) error {
+	if field.Type() == timeType {
+		var t time.Time
+		var err error
+		if f.elemType == string(slack.METDatetimepicker) {
+			var sec int64
+			sec, err = strconv.ParseInt(values[0], 10, 64)
+			t = time.Unix(sec, 0)
+		} else {
+			t, err = time.Parse(f.timeLayout(), values[0])
+		}
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.id, err)
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(values[0])
+	case reflect.Slice:
+		field.Set(reflect.ValueOf(append([]string(nil), values...)).Convert(field.Type()))
+	case reflect.Bool:
+		// A checkbox field is true when any box is checked
+		b, err := strconv.ParseBool(values[0])
+		field.SetBool(err != nil || b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(values[0], 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.id, err)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(values[0], 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.id, err)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(values[0], field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.id, err)
+		}
+		field.SetFloat(n)
+	default:
+		return fmt.Errorf("field %s: cannot decode into %s", f.id, field.Type())
+	}
+	return nil
+}
+
+// formValues renders a field's current value as the strings used for initial values,
+// formatting times with layout. Zero values render as no values.
+func formValues(value reflect.Value, layout string) []string {
+	if value.IsZero() {
+		return nil
+	}
+	if t, ok := value.Interface().(time.Time); ok {
+		return []string{t.Format(layout)}
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return []string{value.String()}
+	case reflect.Slice:
+		values := make([]string, value.Len())
+		for i := range values {
+			values[i] = value.Index(i).String()
+		}
+		return values
+	case reflect.Bool:
+		return []string{"true"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []string{strconv.FormatInt(value.Int(), 10)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []string{strconv.FormatUint(value.Uint(), 10)}
+	case reflect.Float32, reflect.Float64:
+		return []string{formatNumber(value.Float())}
+	default:
+		return nil
+	}
+}
+
+// derefOr returns *p, or fallback if p is nil.
+func derefOr(p *float64, fallback float64) float64 {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
+
+// nonEmpty returns s as a single value, or no values if it is empty.
+func nonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
+
+// snakeCase converts a Go field name such as IncidentID to incident_id.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if i > 0 && (prevLower || (nextLower && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,123 @@
+package blockbuilder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+type incidentForm struct {
+	Title     string    `slack:"label=Title,required"`
+	Severity  string    `slack:"label=Severity,type=static_select,options=sev1:SEV 1|sev2:SEV 2,required"`
+	Owners    []string  `slack:"label=Owners,type=multi_users_select"`
+	Impact    int       `slack:"label=Customers affected"`
+	Public    bool      `slack:"label=Post to status page"`
+	StartedOn time.Time `slack:"id=started_on,label=Started"`
+	Internal  string
+}
+
+func TestFormBlocks(t *testing.T) {
+	blocks, err := blockbuilder.FormBlocks(incidentForm{Severity: "sev2", Impact: 12})
+	if err != nil {
+		t.Fatalf("FormBlocks: %v", err)
+	}
+	if len(blocks) != 6 {
+		t.Fatalf("expected 6 input blocks, got %d", len(blocks))
+	}
+
+	title := blocks[0].(*slack.InputBlock)
+	if title.BlockID != "title" || title.Optional {
+		t.Errorf("unexpected title input: %+v", title)
+	}
+	severity := blocks[1].(*slack.InputBlock).Element.(*slack.SelectBlockElement)
+	if len(severity.Options) != 2 || severity.InitialOption == nil || severity.InitialOption.Value != "sev2" {
+		t.Errorf("unexpected severity select: %+v", severity)
+	}
+	if owners := blocks[2].(*slack.InputBlock).Element.(*slack.MultiSelectBlockElement); owners.Type != slack.MultiOptTypeUser {
+		t.Errorf("unexpected owners element type %q", owners.Type)
+	}
+	if impact := blocks[3].(*slack.InputBlock).Element.(*slack.NumberInputBlockElement); impact.InitialValue != "12" {
+		t.Errorf("unexpected impact initial value %q", impact.InitialValue)
+	}
+	if err := blockbuilder.ValidateBlocks(blocks, blockbuilder.MaxModalBlocks); err != nil {
+		t.Errorf("generated blocks are invalid: %v", err)
+	}
+}
+
+func TestDecodeSubmission(t *testing.T) {
+	callback := slack.InteractionCallback{
+		View: slack.View{State: &slack.ViewState{Values: map[string]map[string]slack.BlockAction{
+			"title":      {"title": {Value: "API latency"}},
+			"severity":   {"severity": {SelectedOption: slack.OptionBlockObject{Value: "sev1"}}},
+			"owners":     {"owners": {SelectedUsers: []string{"U1", "U2"}}},
+			"impact":     {"impact": {Value: "250"}},
+			"public":     {"public": {SelectedOptions: []slack.OptionBlockObject{{Value: "true"}}}},
+			"started_on": {"started_on": {SelectedDate: "2024-03-09"}},
+		}}},
+	}
+
+	var form incidentForm
+	if err := blockbuilder.DecodeSubmission(callback, &form); err != nil {
+		t.Fatalf("DecodeSubmission: %v", err)
+	}
+	want := incidentForm{
+		Title:     "API latency",
+		Severity:  "sev1",
+		Owners:    []string{"U1", "U2"},
+		Impact:    250,
+		Public:    true,
+		StartedOn: time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC),
+	}
+	if form.Title != want.Title || form.Severity != want.Severity || len(form.Owners) != 2 ||
+		form.Impact != want.Impact || !form.Public || !form.StartedOn.Equal(want.StartedOn) {
+		t.Errorf("unexpected form %+v", form)
+	}
+}
+
+func TestFormTimepicker(t *testing.T) {
+	type shiftForm struct {
+		StartsAt time.Time `slack:"type=timepicker"`
+	}
+	blocks, err := blockbuilder.FormBlocks(shiftForm{StartsAt: time.Date(0, 1, 1, 9, 30, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatalf("FormBlocks: %v", err)
+	}
+	if picker := blocks[0].(*slack.InputBlock).Element.(*slack.TimePickerBlockElement); picker.InitialTime != "09:30" {
+		t.Errorf("expected initial time 09:30, got %q", picker.InitialTime)
+	}
+
+	state := &slack.ViewState{Values: map[string]map[string]slack.BlockAction{
+		"starts_at": {"starts_at": {SelectedTime: "17:45"}},
+	}}
+	var form shiftForm
+	if err := blockbuilder.DecodeForm(state, &form); err != nil {
+		t.Fatalf("DecodeForm: %v", err)
+	}
+	if got := form.StartsAt.Format("15:04"); got != "17:45" {
+		t.Errorf("expected 17:45, got %s", got)
+	}
+}
+
+func TestDecodeFormUncheckedCheckbox(t *testing.T) {
+	state := &slack.ViewState{Values: map[string]map[string]slack.BlockAction{
+		"public": {"public": {SelectedOptions: []slack.OptionBlockObject{}}},
+	}}
+	form := incidentForm{Public: true}
+	if err := blockbuilder.DecodeForm(state, &form); err != nil {
+		t.Fatalf("DecodeForm: %v", err)
+	}
+	if form.Public {
+		t.Errorf("expected unchecked checkbox to set Public to false")
+	}
+}
+
+func TestFormBlocksRequiresOptions(t *testing.T) {
+	type tagsForm struct {
+		Tags []string `slack:"label=Tags"`
+	}
+	if _, err := blockbuilder.FormBlocks(tagsForm{}); err == nil {
+		t.Errorf("expected an error for a []string field without options")
+	}
+}
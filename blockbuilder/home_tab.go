@@ -0,0 +1,132 @@
+package blockbuilder
+
+import (
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/mrkdwn"
+	"github.com/slack-go/slack"
+)
+
+// HomeTabBuilder builds an App Home tab view for views.publish.
+type HomeTabBuilder struct {
+	view        slack.HomeTabViewRequest
+	blocks      *BlockBuilder
+	metadataErr error
+}
+
+// NewHomeTabBuilder initializes an empty HomeTabBuilder.
+func NewHomeTabBuilder() *HomeTabBuilder {
+	return &HomeTabBuilder{
+		view: slack.HomeTabViewRequest{
+			Type: slack.VTHomeTab,
+		},
+		blocks: NewBlockBuilder(),
+	}
+}
+
+// CallbackID sets the identifier sent with interactions from the Home tab.
+func (h *HomeTabBuilder) CallbackID(callbackID string) *HomeTabBuilder {
+	h.view.CallbackID = callbackID
+	return h
+}
+
+// ExternalID sets a workspace-unique identifier for the view.
+func (h *HomeTabBuilder) ExternalID(externalID string) *HomeTabBuilder {
+	h.view.ExternalID = externalID
+	return h
+}
+
+// PrivateMetadata encodes data as JSON into the view's private_metadata.
+// Encoding errors are reported by Validate. Read the data back with DecodePrivateMetadata.
+func (h *HomeTabBuilder) PrivateMetadata(data any) *HomeTabBuilder {
+	h.view.PrivateMetadata, h.metadataErr = EncodePrivateMetadata(data)
+	return h
+}
+
+// Blocks sets the content of the Home tab, replacing any blocks added before.
+func (h *HomeTabBuilder) Blocks(builder *BlockBuilder) *HomeTabBuilder {
+	h.blocks = builder
+	return h
+}
+
+// Body returns the BlockBuilder holding the Home tab's blocks, for adding any block type.
+func (h *HomeTabBuilder) Body() *BlockBuilder {
+	return h.blocks
+}
+
+// AddHeader adds a header block.
+func (h *HomeTabBuilder) AddHeader(text string) *HomeTabBuilder {
+	h.blocks.AddHeader(text)
+	return h
+}
+
+// AddSection adds a Markdown section.
+func (h *HomeTabBuilder) AddSection(text string) *HomeTabBuilder {
+	h.blocks.AddSection(text, true)
+	return h
+}
+
+// AddSectionWithButton adds a Markdown section with a button beside the text.
+func (h *HomeTabBuilder) AddSectionWithButton(text string, button slack.BlockElement) *HomeTabBuilder {
+	h.blocks.AddSectionFrom(NewSection().Text(text).Accessory(button))
+	return h
+}
+
+// AddDivider adds a divider block.
+func (h *HomeTabBuilder) AddDivider() *HomeTabBuilder {
+	h.blocks.AddDivider()
+	return h
+}
+
+// AddPanel adds a common Home tab group: a header, one Markdown section per item and a divider.
+func (h *HomeTabBuilder) AddPanel(title string, items ...string) *HomeTabBuilder {
+	h.AddHeader(title)
+	for _, item := range items {
+		h.AddSection(item)
+	}
+	return h.AddDivider()
+}
+
+// AddRefreshAction adds a "Refresh" button with actionID and a context line showing when the
+// Home tab was last updated, rendered in each viewer's time zone.
+func (h *HomeTabBuilder) AddRefreshAction(actionID string, updatedAt time.Time) *HomeTabBuilder {
+	h.blocks.AddActions(NewButtonBuilder(actionID, "Refresh").Value("refresh").Build())
+	h.blocks.AddContext(slack.NewTextBlockObject("mrkdwn", lastUpdatedText(updatedAt), false, false))
+	return h
+}
+
+// Validate checks the Home tab and its blocks against Slack's limits, including MaxModalBlocks.
+// It returns nil or a ValidationErrors listing every violation.
+func (h *HomeTabBuilder) Validate() error {
+	v := &validator{blockIDs: make(map[string]int)}
+
+	v.validateViewIDs(h.view.CallbackID, h.view.ExternalID)
+	if h.metadataErr != nil {
+		v.addf(-1, "", "%s", h.metadataErr)
+	}
+	v.validateBlocks(h.blocks.Build(), MaxModalBlocks)
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// Build returns the Home tab view request. It returns a ValidationErrors instead if the
+// Home tab has more than MaxModalBlocks blocks; call Validate for the remaining checks.
+func (h *HomeTabBuilder) Build() (slack.HomeTabViewRequest, error) {
+	blocks := h.blocks.Build()
+	if len(blocks) > MaxModalBlocks {
+		v := &validator{}
+		v.addf(-1, "", "too many blocks: %d (max %d)", len(blocks), MaxModalBlocks)
+		return slack.HomeTabViewRequest{}, v.errs
+	}
+	view := h.view
+	view.Blocks = slack.Blocks{BlockSet: blocks}
+	return view, nil
+}
+
+// lastUpdatedText renders a "Last updated" line with a Slack date token.
+func lastUpdatedText(t time.Time) string {
+	return "Last updated " + mrkdwn.Date(t, mrkdwn.DateShortPretty+" at "+mrkdwn.Time).String()
+}
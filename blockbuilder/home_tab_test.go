@@ -0,0 +1,57 @@
+package blockbuilder_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestHomeTabBuilder(t *testing.T) {
+	home := blockbuilder.NewHomeTabBuilder().
+		CallbackID("home").
+		AddPanel("Open incidents", "*INC-7* API latency", "*INC-8* Queue backlog").
+		AddRefreshAction("refresh_home", time.Unix(1700000000, 0))
+
+	if err := home.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+
+	view, err := home.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if view.Type != slack.VTHomeTab || view.CallbackID != "home" {
+		t.Errorf("unexpected view: %+v", view)
+	}
+	blocks := view.Blocks.BlockSet
+	if len(blocks) != 6 {
+		t.Fatalf("expected 6 blocks, got %d", len(blocks))
+	}
+	context := blocks[5].(*slack.ContextBlock).ContextElements.Elements[0].(*slack.TextBlockObject)
+	if want := "Last updated <!date^1700000000^{date_short_pretty} at {time}|2023-11-14 22:13 UTC>"; context.Text != want {
+		t.Errorf("expected %q, got %q", want, context.Text)
+	}
+}
+
+func TestHomeTabBlockLimit(t *testing.T) {
+	home := blockbuilder.NewHomeTabBuilder()
+	for i := 0; i <= blockbuilder.MaxModalBlocks; i++ {
+		home.AddSection(fmt.Sprintf("Item %d", i))
+	}
+
+	var verrs blockbuilder.ValidationErrors
+	if err := home.Validate(); !errors.As(err, &verrs) || verrs[0].BlockIndex != -1 {
+		t.Errorf("expected a block limit violation, got %v", err)
+	}
+	view, err := home.Build()
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].BlockIndex != -1 {
+		t.Errorf("expected Build to report the block limit, got %v", err)
+	}
+	if len(view.Blocks.BlockSet) != 0 {
+		t.Errorf("expected no view for %d blocks, got %d blocks", blockbuilder.MaxModalBlocks+1, len(view.Blocks.BlockSet))
+	}
+}
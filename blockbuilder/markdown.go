@@ -0,0 +1,373 @@
+package blockbuilder
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+)
+
+// Patterns for Markdown block syntax.
+var (
+	mdHeadingRe   = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	mdFenceRe     = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})[ \t]*([^`]*)$")
+	mdRuleRe      = regexp.MustCompile(`^ {0,3}((?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	mdSetextRe    = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	mdListItemRe  = regexp.MustCompile(`^([ \t]*)([-*+]|\d{1,9}[.)])(?:[ \t]+(.*))?$`)
+	mdTableSepRe  = regexp.MustCompile(`^[ \t]*\|?[ \t]*:?-+:?[ \t]*(\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	mdImageOnlyRe = regexp.MustCompile(`^!\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)$`)
+)
+
+// mdListItem is one list item with its nesting depth.
+type mdListItem struct {
+	depth   int
+	ordered bool
+	text    string
+}
+
+// FromMarkdown converts CommonMark (with GitHub tables and strikethrough) to Block Kit blocks:
+// headings become header blocks, paragraphs mrkdwn sections, lists, block quotes and code
+// fences rich text, thematic breaks dividers, images on their own line image blocks, and
+// tables sections of fields (two columns) or preformatted text. Inline emphasis, code and
+// links are translated to Slack's syntax and &, < and > are escaped.
+func FromMarkdown(markdown string) []slack.Block {
+	c := &mdConverter{}
+	c.convert(strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n"))
+	c.flushRichText()
+	return c.blocks
+}
+
+// AddMarkdown adds blocks converted from CommonMark. See FromMarkdown.
+func (b *BlockBuilder) AddMarkdown(markdown string) *BlockBuilder {
+	b.blocks = append(b.blocks, FromMarkdown(markdown)...)
+	return b
+}
+
+// AddMarkdown adds blocks converted from CommonMark to the attachment. See FromMarkdown.
+func (a *AttachmentBuilder) AddMarkdown(markdown string) *AttachmentBuilder {
+	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, FromMarkdown(markdown)...)
+	return a
+}
+
+// mdConverter accumulates blocks while scanning Markdown lines.
+type mdConverter struct {
+	blocks   []slack.Block
+	richText []slack.RichTextElement
+}
+
+// convert scans lines and emits a block for each Markdown construct.
+func (c *mdConverter) convert(lines []string) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case mdFenceRe.MatchString(line):
+			m := mdFenceRe.FindStringSubmatch(line)
+			fence := m[1]
+			var code []string
+			i++
+			for i < len(lines) {
+				t := strings.TrimSpace(lines[i])
+				if strings.HasPrefix(t, fence[:1]) && runLength(t, 0, fence[0]) >= len(fence) && strings.Trim(t, fence[:1]) == "" {
+					i++
+					break
+				}
+				code = append(code, lines[i])
+				i++
+			}
+			c.addRichText(&slack.RichTextPreformatted{
+				RichTextSection: slack.RichTextSection{
+					Type:     slack.RTEPreformatted,
+					Elements: []slack.RichTextSectionElement{RichTextSpan(strings.Join(code, "\n"))},
+				},
+			})
+
+		case mdHeadingRe.MatchString(line):
+			c.addHeading(mdHeadingRe.FindStringSubmatch(line)[2])
+			i++
+
+		case mdRuleRe.MatchString(line):
+			c.addBlock(NewDividerBlock())
+			i++
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quote []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+				t := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quote = append(quote, strings.TrimPrefix(t, " "))
+				i++
+			}
+			// Blank quoted lines separate paragraphs
+			var paras []string
+			for start := 0; start < len(quote); {
+				end := start
+				for end < len(quote) && strings.TrimSpace(quote[end]) != "" {
+					end++
+				}
+				if end > start {
+					paras = append(paras, joinLines(quote[start:end]))
+				}
+				start = end + 1
+			}
+			c.addRichText(&slack.RichTextQuote{
+				Type:     slack.RTEQuote,
+				Elements: spansToRichText(parseInline(strings.Join(paras, "\n"))),
+			})
+
+		case mdListItemRe.MatchString(line):
+			i = c.convertList(lines, i)
+
+		case i+1 < len(lines) && strings.Contains(line, "|") && mdTableSepRe.MatchString(lines[i+1]) && strings.Contains(lines[i+1], "-"):
+			rows := [][]string{splitTableRow(line)}
+			i += 2
+			for i < len(lines) && strings.Contains(lines[i], "|") && strings.TrimSpace(lines[i]) != "" {
+				rows = append(rows, splitTableRow(lines[i]))
+				i++
+			}
+			c.addTable(rows)
+
+		default:
+			i = c.convertParagraph(lines, i)
+		}
+	}
+}
+
+// convertParagraph emits the paragraph or setext heading starting at lines[i] and returns
+// the index of the next line.
+func (c *mdConverter) convertParagraph(lines []string, i int) int {
+	var para []string
+	for i < len(lines) {
+		line := lines[i]
+		if len(para) > 0 && mdSetextRe.MatchString(line) {
+			c.addHeading(strings.Join(para, " "))
+			return i + 1
+		}
+		if strings.TrimSpace(line) == "" || (len(para) > 0 && startsBlock(line)) {
+			break
+		}
+		para = append(para, line)
+		i++
+	}
+
+	text := joinLines(para)
+
+	if m := mdImageOnlyRe.FindStringSubmatch(text); m != nil {
+		alt := m[1]
+		if alt == "" {
+			alt = "image"
+		}
+		c.addBlock(NewImageBlock(m[2], alt))
+		return i
+	}
+	for _, chunk := range SplitText(spansToMrkdwn(parseInline(text)), MaxSectionTextLength) {
+		c.addBlock(NewSectionBlock(chunk, true))
+	}
+	return i
+}
+
+// convertList emits the list starting at lines[i] as rich text lists and returns the index
+// of the next line.
+func (c *mdConverter) convertList(lines []string, i int) int {
+	var items []mdListItem
+	var indents []int
+	for i < len(lines) {
+		line := lines[i]
+		if m := mdListItemRe.FindStringSubmatch(line); m != nil && !mdRuleRe.MatchString(line) {
+			indent := len(strings.ReplaceAll(m[1], "\t", "    "))
+			for len(indents) > 0 && indent < indents[len(indents)-1] {
+				indents = indents[:len(indents)-1]
+			}
+			if len(indents) == 0 || indent > indents[len(indents)-1] {
+				indents = append(indents, indent)
+			}
+			items = append(items, mdListItem{
+				depth:   len(indents) - 1,
+				ordered: !strings.ContainsAny(m[2], "-*+"),
+				text:    strings.TrimSpace(m[3]),
+			})
+			i++
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			// A blank line continues the list only if another item or indented text follows
+			if i+1 < len(lines) && (mdListItemRe.MatchString(lines[i+1]) || strings.HasPrefix(lines[i+1], "  ")) {
+				i++
+				continue
+			}
+			break
+		}
+		if startsBlock(line) {
+			break
+		}
+		// Continuation of the previous item
+		items[len(items)-1].text += " " + trimmed
+		i++
+	}
+
+	// Consecutive items with the same depth and style form one rich text list
+	for start := 0; start < len(items); {
+		end := start + 1
+		for end < len(items) && items[end].depth == items[start].depth && items[end].ordered == items[start].ordered {
+			end++
+		}
+		style := slack.RTEListBullet
+		if items[start].ordered {
+			style = slack.RTEListOrdered
+		}
+		elements := make([]slack.RichTextElement, 0, end-start)
+		for _, item := range items[start:end] {
+			elements = append(elements, slack.NewRichTextSection(spansToRichText(parseInline(item.text))...))
+		}
+		c.addRichText(slack.NewRichTextList(style, items[start].depth, elements...))
+		start = end
+	}
+	return i
+}
+
+// addHeading emits a header block with the heading's text, without formatting.
+func (c *mdConverter) addHeading(text string) {
+	plain := strings.TrimSpace(spansToPlainText(parseInline(text)))
+	if plain == "" {
+		return
+	}
+	c.addBlock(NewHeaderBlock(truncateText(plain, MaxHeaderTextLength)))
+}
+
+// addTable emits a two-column table as section fields and wider tables as preformatted text.
+func (c *mdConverter) addTable(rows [][]string) {
+	if len(rows[0]) == 2 {
+		var fields []*slack.TextBlockObject
+		for r, row := range rows {
+			for col := 0; col < 2; col++ {
+				text := ""
+				if col < len(row) {
+					text = spansToMrkdwn(parseInline(row[col]))
+				}
+				if r == 0 && text != "" {
+					text = "*" + text + "*"
+				}
+				if text == "" {
+					text = " "
+				}
+				fields = append(fields, slack.NewTextBlockObject("mrkdwn", truncateText(text, MaxSectionFieldLength), false, false))
+			}
+		}
+		for _, block := range splitSection(&slack.SectionBlock{Type: slack.MBTSection, Fields: fields}) {
+			c.addBlock(block)
+		}
+		return
+	}
+
+	// Align columns for a monospace rendering
+	plain := make([][]string, len(rows))
+	var widths []int
+	for r, row := range rows {
+		for col, cell := range row {
+			text := spansToPlainText(parseInline(cell))
+			plain[r] = append(plain[r], text)
+			if col >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[col] = max(widths[col], utf8.RuneCountInString(text))
+		}
+	}
+	var lines []string
+	for r, row := range plain {
+		cells := make([]string, len(row))
+		for col, text := range row {
+			cells[col] = text + strings.Repeat(" ", widths[col]-utf8.RuneCountInString(text))
+		}
+		lines = append(lines, strings.TrimRight(strings.Join(cells, " | "), " "))
+		if r == 0 {
+			seps := make([]string, len(widths))
+			for col, w := range widths {
+				seps[col] = strings.Repeat("-", w)
+			}
+			lines = append(lines, strings.Join(seps, "-+-"))
+		}
+	}
+	c.addRichText(&slack.RichTextPreformatted{
+		RichTextSection: slack.RichTextSection{
+			Type:     slack.RTEPreformatted,
+			Elements: []slack.RichTextSectionElement{RichTextSpan(strings.Join(lines, "\n"))},
+		},
+	})
+}
+
+// addBlock emits a block, first closing any pending rich text block.
+func (c *mdConverter) addBlock(block slack.Block) {
+	c.flushRichText()
+	c.blocks = append(c.blocks, block)
+}
+
+// addRichText queues an element; consecutive rich text elements share one block.
+func (c *mdConverter) addRichText(element slack.RichTextElement) {
+	c.richText = append(c.richText, element)
+}
+
+// flushRichText emits the pending rich text elements as one block.
+func (c *mdConverter) flushRichText() {
+	if len(c.richText) == 0 {
+		return
+	}
+	c.blocks = append(c.blocks, NewRichTextBlock(c.richText...))
+	c.richText = nil
+}
+
+// joinLines joins the lines of a paragraph: soft line breaks become spaces and hard breaks
+// (two trailing spaces or a backslash) newlines.
+func joinLines(lines []string) string {
+	var b strings.Builder
+	for i, line := range lines {
+		text := strings.TrimLeft(line, " \t")
+		hard := strings.HasSuffix(text, "  ") || strings.HasSuffix(text, "\\")
+		text = strings.TrimRight(strings.TrimSuffix(strings.TrimRight(text, " "), "\\"), " \t")
+		b.WriteString(text)
+		if i < len(lines)-1 {
+			if hard {
+				b.WriteString("\n")
+			} else {
+				b.WriteString(" ")
+			}
+		}
+	}
+	return b.String()
+}
+
+// startsBlock reports whether line begins a construct that interrupts a paragraph or list item.
+func startsBlock(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return mdFenceRe.MatchString(line) || mdHeadingRe.MatchString(line) || mdRuleRe.MatchString(line) ||
+		strings.HasPrefix(trimmed, ">") || mdListItemRe.MatchString(line)
+}
+
+// splitTableRow splits a table row into trimmed cells, honoring escaped pipes.
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, "\\|") {
+		line = line[:len(line)-1]
+	}
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
@@ -0,0 +1,370 @@
+package blockbuilder
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/mrkdwn"
+	"github.com/slack-go/slack"
+)
+
+// mdSpan is a run of inline Markdown text with uniform formatting.
+type mdSpan struct {
+	text   string
+	bold   bool
+	italic bool
+	strike bool
+	code   bool
+	url    string
+}
+
+// mdStyle is the formatting inherited by nested inline content.
+type mdStyle struct {
+	bold, italic, strike bool
+	url                  string
+}
+
+// parseInline parses CommonMark inline syntax: code spans, emphasis, strikethrough, links,
+// images (kept as links), autolinks and backslash escapes.
+func parseInline(text string) []mdSpan {
+	var spans []mdSpan
+	parseInlineInto(&spans, text, mdStyle{})
+	return spans
+}
+
+// parseInlineInto appends the spans of text, formatted with style, to spans.
+func parseInlineInto(spans *[]mdSpan, text string, style mdStyle) {
+	var plain strings.Builder
+	emit := func(s mdSpan) {
+		if plain.Len() > 0 {
+			appendSpan(spans, mdSpan{text: plain.String(), bold: style.bold, italic: style.italic, strike: style.strike, url: style.url})
+			plain.Reset()
+		}
+		if s.text != "" {
+			appendSpan(spans, s)
+		}
+	}
+
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && isASCIIPunct(text[i+1]):
+			plain.WriteByte(text[i+1])
+			i += 2
+			continue
+
+		case c == '`':
+			run := runLength(text, i, '`')
+			if end := strings.Index(text[i+run:], strings.Repeat("`", run)); end >= 0 {
+				code := text[i+run : i+run+end]
+				if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+					code = code[1 : len(code)-1]
+				}
+				emit(mdSpan{text: code, code: true, bold: style.bold, italic: style.italic, strike: style.strike, url: style.url})
+				i += run + end + run
+				continue
+			}
+			plain.WriteString(text[i : i+run])
+			i += run
+			continue
+
+		case c == '!' && strings.HasPrefix(text[i:], "!["):
+			if label, url, n, ok := parseLink(text[i+1:]); ok {
+				if label == "" {
+					label = url
+				}
+				emit(mdSpan{})
+				parseInlineInto(spans, label, mdStyle{bold: style.bold, italic: style.italic, strike: style.strike, url: url})
+				i += 1 + n
+				continue
+			}
+
+		case c == '[':
+			if label, url, n, ok := parseLink(text[i:]); ok && style.url == "" {
+				emit(mdSpan{})
+				inner := style
+				inner.url = url
+				parseInlineInto(spans, label, inner)
+				i += n
+				continue
+			}
+
+		case c == '<':
+			if end := strings.IndexByte(text[i:], '>'); end > 0 {
+				target := text[i+1 : i+end]
+				if isAutolink(target) {
+					emit(mdSpan{})
+					appendSpan(spans, mdSpan{text: target, bold: style.bold, italic: style.italic, strike: style.strike, url: target})
+					i += end + 1
+					continue
+				}
+			}
+
+		case c == '~' && strings.HasPrefix(text[i:], "~~"):
+			if end, ok := closingDelimiter(text, i, "~~"); ok {
+				emit(mdSpan{})
+				inner := style
+				inner.strike = true
+				parseInlineInto(spans, text[i+2:end], inner)
+				i = end + 2
+				continue
+			}
+
+		case c == '*' || c == '_':
+			delim := string(c)
+			if runLength(text, i, c) >= 2 {
+				delim += string(c)
+			}
+			// Emphasis cannot start inside a word, which Slack could not render anyway
+			if i > 0 && isWordByte(text[i-1]) {
+				break
+			}
+			if end, ok := closingDelimiter(text, i, delim); ok {
+				emit(mdSpan{})
+				inner := style
+				if len(delim) == 2 {
+					inner.bold = true
+				} else {
+					inner.italic = true
+				}
+				parseInlineInto(spans, text[i+len(delim):end], inner)
+				i = end + len(delim)
+				continue
+			}
+			if len(delim) == 2 {
+				if end, ok := closingDelimiter(text, i, delim[:1]); ok {
+					plain.WriteByte(c)
+					emit(mdSpan{})
+					inner := style
+					inner.italic = true
+					parseInlineInto(spans, text[i+2:end], inner)
+					i = end + 1
+					continue
+				}
+			}
+			plain.WriteString(delim)
+			i += len(delim)
+			continue
+		}
+
+		plain.WriteByte(c)
+		i++
+	}
+	emit(mdSpan{})
+}
+
+// appendSpan appends s, merging it into the previous span when the formatting matches.
+func appendSpan(spans *[]mdSpan, s mdSpan) {
+	if n := len(*spans); n > 0 {
+		last := &(*spans)[n-1]
+		if last.bold == s.bold && last.italic == s.italic && last.strike == s.strike && last.code == s.code && last.url == s.url {
+			last.text += s.text
+			return
+		}
+	}
+	*spans = append(*spans, s)
+}
+
+// closingDelimiter finds the delimiter closing the one opened at text[start:], requiring
+// non-space content directly inside both delimiters and skipping code spans.
+func closingDelimiter(text string, start int, delim string) (int, bool) {
+	from := start + len(delim)
+	if from >= len(text) || text[from] == ' ' {
+		return 0, false
+	}
+	for i := from + 1; i+len(delim) <= len(text); i++ {
+		switch {
+		case text[i] == '\\':
+			i++
+		case text[i] == '`':
+			run := runLength(text, i, '`')
+			if end := strings.Index(text[i+run:], strings.Repeat("`", run)); end >= 0 {
+				i += run + end + run - 1
+			}
+		case strings.HasPrefix(text[i:], delim) && text[i-1] != ' ':
+			// Skip longer runs such as ** when looking for *
+			if len(delim) == 1 && i+1 < len(text) && text[i+1] == delim[0] {
+				i++
+				continue
+			}
+			if delim[0] == '_' && i+len(delim) < len(text) && isWordByte(text[i+len(delim)]) {
+				continue
+			}
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// parseLink parses [label](url "title") at the start of text and returns the label, the URL
+// and the number of bytes consumed.
+func parseLink(text string) (label, url string, n int, ok bool) {
+	depth := 0
+	closeLabel := -1
+	for i := 0; i < len(text) && closeLabel < 0; i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closeLabel = i
+			}
+		}
+	}
+	if closeLabel < 0 || closeLabel+1 >= len(text) || text[closeLabel+1] != '(' {
+		return "", "", 0, false
+	}
+	end := destinationEnd(text, closeLabel+2)
+	if end < 0 {
+		return "", "", 0, false
+	}
+	dest := strings.TrimSpace(text[closeLabel+2 : end])
+	if sp := strings.IndexAny(dest, " \t"); sp >= 0 {
+		dest = dest[:sp] // drop the link title
+	}
+	dest = strings.TrimSuffix(strings.TrimPrefix(dest, "<"), ">")
+	return text[1:closeLabel], dest, end + 1, true
+}
+
+// destinationEnd returns the index of the ) closing a link destination that starts at
+// text[start], or -1. Parentheses in the destination must be balanced, as in CommonMark,
+// unless it is wrapped in <...>.
+func destinationEnd(text string, start int) int {
+	depth, inAngle := 0, false
+	for i := start; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == '\\':
+			i++
+		case c == '<' && i == start:
+			inAngle = true
+		case c == '>' && inAngle:
+			inAngle = false
+		case inAngle || c == '\n':
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// isAutolink reports whether target looks like the URL of a CommonMark autolink.
+func isAutolink(target string) bool {
+	if strings.ContainsAny(target, " <>") {
+		return false
+	}
+	scheme, _, ok := strings.Cut(target, ":")
+	return ok && len(scheme) >= 2 && strings.IndexFunc(scheme, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '.' && r != '-'
+	}) < 0
+}
+
+// runLength counts consecutive c bytes starting at text[i].
+func runLength(text string, i int, c byte) int {
+	n := 0
+	for i+n < len(text) && text[i+n] == c {
+		n++
+	}
+	return n
+}
+
+// isASCIIPunct reports whether c can be backslash-escaped in CommonMark.
+func isASCIIPunct(c byte) bool {
+	return c < utf8.RuneSelf && unicode.IsPunct(rune(c)) || strings.IndexByte("$+<=>^`|~", c) >= 0
+}
+
+// isWordByte reports whether c is an ASCII letter or digit.
+func isWordByte(c byte) bool {
+	return c < utf8.RuneSelf && (unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)))
+}
+
+// spansToMrkdwn renders spans as Slack mrkdwn, escaping text and link URLs.
+// Whitespace is kept outside formatting markers, and formatting that touches a letter or
+// digit of a neighbouring span is dropped, since Slack would show the markers literally.
+func spansToMrkdwn(spans []mdSpan) string {
+	var b strings.Builder
+	for i, s := range spans {
+		if s.url != "" {
+			label := ""
+			if s.text != s.url {
+				// Slack has no escape for | in link text
+				label = strings.ReplaceAll(s.text, "|", "¦")
+			}
+			b.WriteString(mrkdwn.Link(s.url, label).String())
+			continue
+		}
+		text := mrkdwn.Escape(s.text).String()
+		core := strings.TrimSpace(text)
+		if core == "" {
+			b.WriteString(text)
+			continue
+		}
+		lead := text[:strings.Index(text, core)]
+		trail := text[len(lead)+len(core):]
+		if s.code {
+			// A backtick would end the code span, so show a look-alike as mrkdwn.Code does
+			core = "`" + strings.ReplaceAll(core, "`", "ˋ") + "`"
+		}
+		intraword := (lead == "" && i > 0 && endsWithWord(spans[i-1].text)) ||
+			(trail == "" && i+1 < len(spans) && startsWithWord(spans[i+1].text))
+		if !intraword {
+			if s.strike {
+				core = "~" + core + "~"
+			}
+			if s.italic {
+				core = "_" + core + "_"
+			}
+			if s.bold {
+				core = "*" + core + "*"
+			}
+		}
+		b.WriteString(lead + core + trail)
+	}
+	return b.String()
+}
+
+// endsWithWord reports whether text ends with a letter or digit.
+func endsWithWord(text string) bool {
+	r, _ := utf8.DecodeLastRuneInString(text)
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// startsWithWord reports whether text starts with a letter or digit.
+func startsWithWord(text string) bool {
+	r, _ := utf8.DecodeRuneInString(text)
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// spansToRichText converts spans to rich text section elements.
+func spansToRichText(spans []mdSpan) []slack.RichTextSectionElement {
+	elements := make([]slack.RichTextSectionElement, 0, len(spans))
+	for _, s := range spans {
+		var style *slack.RichTextSectionTextStyle
+		if s.bold || s.italic || s.strike || s.code {
+			style = &slack.RichTextSectionTextStyle{Bold: s.bold, Italic: s.italic, Strike: s.strike, Code: s.code}
+		}
+		if s.url != "" {
+			elements = append(elements, slack.NewRichTextSectionLinkElement(s.url, s.text, style))
+		} else {
+			elements = append(elements, slack.NewRichTextSectionTextElement(s.text, style))
+		}
+	}
+	return elements
+}
+
+// spansToPlainText returns the text of spans without formatting.
+func spansToPlainText(spans []mdSpan) string {
+	var b strings.Builder
+	for _, s := range spans {
+		b.WriteString(s.text)
+	}
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package blockbuilder_test
+
+import (
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestFromMarkdown(t *testing.T) {
+	md := "# Release **v1.2**\n\n" +
+		"Fixes **auth**, _retries_ and ~~flaky~~ `a<b` tests. See [notes](https://example.com/notes) & <https://example.com>.\n\n" +
+		"- api\n  - nested\n1. first\n\n" +
+		"> quoted\n\n" +
+		"```\nmake test\n```\n\n" +
+		"| Service | Status |\n|---|---|\n| api | ok |\n\n" +
+		"---\n\n" +
+		"![diagram](https://example.com/d.png)\n"
+
+	blocks := blockbuilder.FromMarkdown(md)
+	if len(blocks) != 6 {
+		t.Fatalf("expected 6 blocks, got %d", len(blocks))
+	}
+
+	if header := blocks[0].(*slack.HeaderBlock); header.Text.Text != "Release v1.2" {
+		t.Errorf("unexpected header %q", header.Text.Text)
+	}
+
+	want := "Fixes *auth*, _retries_ and ~flaky~ `a&lt;b` tests. See <https://example.com/notes|notes> &amp; <https://example.com>."
+	if section := blocks[1].(*slack.SectionBlock); section.Text.Text != want {
+		t.Errorf("unexpected section text:\n got %q\nwant %q", section.Text.Text, want)
+	}
+
+	richText := blocks[2].(*slack.RichTextBlock)
+	wantTypes := []slack.RichTextElementType{slack.RTEList, slack.RTEList, slack.RTEList, slack.RTEQuote, slack.RTEPreformatted}
+	if len(richText.Elements) != len(wantTypes) {
+		t.Fatalf("expected %d rich text elements, got %d", len(wantTypes), len(richText.Elements))
+	}
+	for i, want := range wantTypes {
+		if got := richText.Elements[i].RichTextElementType(); got != want {
+			t.Errorf("rich text element %d: expected %s, got %s", i, want, got)
+		}
+	}
+	if nested := richText.Elements[1].(*slack.RichTextList); nested.Indent != 1 {
+		t.Errorf("expected nested list indent 1, got %d", nested.Indent)
+	}
+
+	if table := blocks[3].(*slack.SectionBlock); len(table.Fields) != 4 || table.Fields[0].Text != "*Service*" {
+		t.Errorf("unexpected table section: %+v", table.Fields)
+	}
+	if _, ok := blocks[4].(*slack.DividerBlock); !ok {
+		t.Errorf("expected divider, got %T", blocks[4])
+	}
+	if image := blocks[5].(*slack.ImageBlock); image.AltText != "diagram" {
+		t.Errorf("unexpected image alt text %q", image.AltText)
+	}
+
+	if err := blockbuilder.ValidateBlocks(blocks, blockbuilder.MaxMessageBlocks); err != nil {
+		t.Errorf("converted blocks are invalid: %v", err)
+	}
+}
+
+func TestFromMarkdownInline(t *testing.T) {
+	tests := map[string]string{
+		"snake_case_name":                "snake_case_name",
+		"2*3*4":                          "2*3*4",
+		"**bold _and italic_**":          "*bold* *_and italic_*",
+		`\*not italic\*`:                 "*not italic*",
+		"**unclosed":                     "**unclosed",
+		"line one  \nline two":           "line one\nline two",
+		"[a|b](https://e.com)":           "<https://e.com|a¦b>",
+		"[docs](https://a><!channel><b)": "<https://a&gt;&lt;!channel&gt;&lt;b|docs>",
+		"[docs](https://e.com/a|b)":      "<https://e.com/a%7Cb|docs>",
+		"<https://e.com/?a=1&b=2>":       "<https://e.com/?a=1&amp;b=2>",
+		"use `**raw**` literally":        "use `**raw**` literally",
+		"[wiki](https://en.wikipedia.org/wiki/Foo_(bar))": "<https://en.wikipedia.org/wiki/Foo_(bar)|wiki>",
+		"[x](<https://e.com/a)b>)":                        "<https://e.com/a)b|x>",
+		"``a`b``":                                         "`aˋb`",
+	}
+	for md, want := range tests {
+		section := blockbuilder.FromMarkdown(md)[0].(*slack.SectionBlock)
+		if section.Text.Text != want {
+			t.Errorf("%q: got %q, want %q", md, section.Text.Text, want)
+		}
+	}
+}
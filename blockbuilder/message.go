@@ -0,0 +1,261 @@
+package blockbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+)
+
+// MessageBuilder assembles a complete message: blocks, attachments, fallback text, threading,
+// unfurling, identity overrides and metadata.
+type MessageBuilder struct {
+	channel     string
+	text        string
+	blocks      []slack.Block
+	attachments []slack.Attachment
+	threadTS    string
+	broadcast   bool
+	unfurlLinks *bool
+	unfurlMedia *bool
+	username    string
+	iconURL     string
+	iconEmoji   string
+	ephemeral   string
+	metadata    *slack.SlackMetadata
+
+	responseType    string
+	replaceOriginal bool
+	deleteOriginal  bool
+}
+
+// messagePayload is the JSON form of a message, as accepted by chat.postMessage, incoming
+// webhooks and response_url.
+type messagePayload struct {
+	Channel        string               `json:"channel,omitempty"`
+	User           string               `json:"user,omitempty"`
+	Text           string               `json:"text,omitempty"`
+	Blocks         []slack.Block        `json:"blocks,omitempty"`
+	Attachments    []slack.Attachment   `json:"attachments,omitempty"`
+	ThreadTS       string               `json:"thread_ts,omitempty"`
+	ReplyBroadcast bool                 `json:"reply_broadcast,omitempty"`
+	UnfurlLinks    *bool                `json:"unfurl_links,omitempty"`
+	UnfurlMedia    *bool                `json:"unfurl_media,omitempty"`
+	Username       string               `json:"username,omitempty"`
+	IconURL        string               `json:"icon_url,omitempty"`
+	IconEmoji      string               `json:"icon_emoji,omitempty"`
+	Metadata       *slack.SlackMetadata `json:"metadata,omitempty"`
+
+	ResponseType    string `json:"response_type,omitempty"`
+	ReplaceOriginal bool   `json:"replace_original,omitempty"`
+	DeleteOriginal  bool   `json:"delete_original,omitempty"`
+}
+
+// NewMessageBuilder initializes an empty MessageBuilder.
+func NewMessageBuilder() *MessageBuilder {
+	return &MessageBuilder{}
+}
+
+// Channel sets the channel included in the JSON payload. Options built with Build leave the
+// channel to the PostMessage call.
+func (m *MessageBuilder) Channel(channelID string) *MessageBuilder {
+	m.channel = channelID
+	return m
+}
+
+// Text sets the top-level mrkdwn text used for notifications. Without it, fallback text is
+// derived from the blocks and attachments with MessageFallbackText.
+func (m *MessageBuilder) Text(text string) *MessageBuilder {
+	m.text = text
+	return m
+}
+
+// Blocks adds blocks to the message.
+func (m *MessageBuilder) Blocks(blocks ...slack.Block) *MessageBuilder {
+	m.blocks = append(m.blocks, blocks...)
+	return m
+}
+
+// BlocksFrom adds the blocks of a BlockBuilder to the message.
+func (m *MessageBuilder) BlocksFrom(builder *BlockBuilder) *MessageBuilder {
+	return m.Blocks(builder.Build()...)
+}
+
+// Attachments adds attachments to the message.
+func (m *MessageBuilder) Attachments(attachments ...slack.Attachment) *MessageBuilder {
+	m.attachments = append(m.attachments, attachments...)
+	return m
+}
+
+// AttachmentFrom adds the attachment built by an AttachmentBuilder to the message.
+func (m *MessageBuilder) AttachmentFrom(builder *AttachmentBuilder) *MessageBuilder {
+	return m.Attachments(builder.Build())
+}
+
+// Thread posts the message as a reply in the thread of the message with timestamp ts.
+func (m *MessageBuilder) Thread(ts string) *MessageBuilder {
+	m.threadTS = ts
+	return m
+}
+
+// Broadcast also shows a thread reply in the channel.
+func (m *MessageBuilder) Broadcast() *MessageBuilder {
+	m.broadcast = true
+	return m
+}
+
+// UnfurlLinks enables or disables previews of links to text content.
+func (m *MessageBuilder) UnfurlLinks(enabled bool) *MessageBuilder {
+	m.unfurlLinks = &enabled
+	return m
+}
+
+// UnfurlMedia enables or disables previews of links to media content.
+func (m *MessageBuilder) UnfurlMedia(enabled bool) *MessageBuilder {
+	m.unfurlMedia = &enabled
+	return m
+}
+
+// Username overrides the bot's name. It requires the chat:write.customize scope.
+func (m *MessageBuilder) Username(username string) *MessageBuilder {
+	m.username = username
+	return m
+}
+
+// IconURL overrides the bot's icon with an image. It requires the chat:write.customize scope.
+func (m *MessageBuilder) IconURL(url string) *MessageBuilder {
+	m.iconURL = url
+	return m
+}
+
+// IconEmoji overrides the bot's icon with an emoji, e.g. ":robot_face:". It requires the
+// chat:write.customize scope.
+func (m *MessageBuilder) IconEmoji(emoji string) *MessageBuilder {
+	m.iconEmoji = emoji
+	return m
+}
+
+// Ephemeral posts the message so that only the user with userID sees it.
+func (m *MessageBuilder) Ephemeral(userID string) *MessageBuilder {
+	m.ephemeral = userID
+	return m
+}
+
+// Metadata attaches event metadata to the message.
+func (m *MessageBuilder) Metadata(eventType string, payload map[string]any) *MessageBuilder {
+	m.metadata = &slack.SlackMetadata{EventType: eventType, EventPayload: payload}
+	return m
+}
+
+// Validate checks the message's blocks, attachments and settings against Slack's limits.
+// It returns nil or a ValidationErrors listing every violation. Problems inside an
+// attachment are reported for the whole message, prefixed with the attachment's index.
+func (m *MessageBuilder) Validate() error {
+	var errs ValidationErrors
+	if err := ValidateBlocks(m.blocks, MaxMessageBlocks); err != nil {
+		errs = append(errs, err.(ValidationErrors)...)
+	}
+	add := func(message string) {
+		errs = append(errs, ValidationError{BlockIndex: -1, Message: message})
+	}
+	if len(m.blocks) == 0 && len(m.attachments) == 0 && m.text == "" {
+		add("message has no text, blocks or attachments")
+	}
+	if n := utf8.RuneCountInString(m.text); n > MaxMessageTextLength {
+		add(fmt.Sprintf("text too long: %d characters (max %d)", n, MaxMessageTextLength))
+	}
+	if m.broadcast && m.threadTS == "" {
+		add("broadcast requires a thread timestamp")
+	}
+	if m.metadata != nil && m.metadata.EventType == "" {
+		add("metadata requires an event type")
+	}
+	for i, attachment := range m.attachments {
+		for _, err := range validateAttachment(attachment) {
+			add(fmt.Sprintf("attachment %d: %s", i, err.Error()))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// Build returns the options to pass to PostMessage, PostEphemeral or SendMessage.
+//
+//	api.PostMessage(channelID, message.Build()...)
+func (m *MessageBuilder) Build() []slack.MsgOption {
+	opts := []slack.MsgOption{slack.MsgOptionText(m.fallbackText(), false)}
+	if m.ephemeral != "" {
+		opts = append(opts, slack.MsgOptionPostEphemeral(m.ephemeral))
+	}
+	if len(m.blocks) > 0 {
+		opts = append(opts, slack.MsgOptionBlocks(m.blocks...))
+	}
+	if len(m.attachments) > 0 {
+		opts = append(opts, slack.MsgOptionAttachments(m.attachments...))
+	}
+	if m.threadTS != "" {
+		opts = append(opts, slack.MsgOptionTS(m.threadTS))
+	}
+	if m.broadcast {
+		opts = append(opts, slack.MsgOptionBroadcast())
+	}
+	if m.unfurlLinks != nil {
+		if *m.unfurlLinks {
+			opts = append(opts, slack.MsgOptionEnableLinkUnfurl())
+		} else {
+			opts = append(opts, slack.MsgOptionDisableLinkUnfurl())
+		}
+	}
+	if m.unfurlMedia != nil && !*m.unfurlMedia {
+		opts = append(opts, slack.MsgOptionDisableMediaUnfurl())
+	}
+	if m.username != "" {
+		opts = append(opts, slack.MsgOptionUsername(m.username))
+	}
+	if m.iconURL != "" {
+		opts = append(opts, slack.MsgOptionIconURL(m.iconURL))
+	}
+	if m.iconEmoji != "" {
+		opts = append(opts, slack.MsgOptionIconEmoji(m.iconEmoji))
+	}
+	if m.metadata != nil {
+		opts = append(opts, slack.MsgOptionMetadata(*m.metadata))
+	}
+	return opts
+}
+
+// JSON returns the message as a JSON payload for chat.postMessage, an incoming webhook or
+// a response_url.
+func (m *MessageBuilder) JSON() ([]byte, error) {
+	return json.Marshal(messagePayload{
+		Channel:        m.channel,
+		User:           m.ephemeral,
+		Text:           m.fallbackText(),
+		Blocks:         m.blocks,
+		Attachments:    m.attachments,
+		ThreadTS:       m.threadTS,
+		ReplyBroadcast: m.broadcast,
+		UnfurlLinks:    m.unfurlLinks,
+		UnfurlMedia:    m.unfurlMedia,
+		Username:       m.username,
+		IconURL:        m.iconURL,
+		IconEmoji:      m.iconEmoji,
+		Metadata:       m.metadata,
+
+		ResponseType:    m.responseType,
+		ReplaceOriginal: m.replaceOriginal,
+		DeleteOriginal:  m.deleteOriginal,
+	})
+}
+
+// fallbackText returns the text set with Text, or text derived from the blocks and
+// attachments, escaped so it is shown literally.
+func (m *MessageBuilder) fallbackText() string {
+	if m.text != "" {
+		return m.text
+	}
+	return MessageFallbackText(m.blocks, m.attachments, 0)
+}
@@ -0,0 +1,126 @@
+package blockbuilder_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestMessageBuilderOptions(t *testing.T) {
+	message := blockbuilder.NewMessageBuilder().
+		BlocksFrom(blockbuilder.NewBlockBuilder().AddHeader("Deploy <finished>")).
+		AttachmentFrom(blockbuilder.NewAttachmentBuilder(blockbuilder.ColorGood).AddSection("ok", false)).
+		Thread("123.456").
+		Broadcast().
+		UnfurlLinks(false).
+		UnfurlMedia(false).
+		Username("deploybot").
+		IconEmoji(":rocket:").
+		Metadata("deploy_finished", map[string]any{"version": "1.2"})
+
+	if err := message.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	endpoint, values, err := slack.UnsafeApplyMsgOptions("token", "C1", "https://slack.com/api/", message.Build()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if endpoint != "https://slack.com/api/chat.postMessage" {
+		t.Errorf("endpoint = %q", endpoint)
+	}
+	want := map[string]string{
+		"text":            "Deploy &lt;finished&gt;",
+		"thread_ts":       "123.456",
+		"reply_broadcast": "true",
+		"unfurl_links":    "false",
+		"unfurl_media":    "false",
+		"username":        "deploybot",
+		"icon_emoji":      ":rocket:",
+	}
+	for key, value := range want {
+		if got := values.Get(key); got != value {
+			t.Errorf("%s = %q, want %q", key, got, value)
+		}
+	}
+	for _, key := range []string{"blocks", "attachments", "metadata"} {
+		if values.Get(key) == "" {
+			t.Errorf("%s not set", key)
+		}
+	}
+}
+
+func TestMessageBuilderEphemeral(t *testing.T) {
+	message := blockbuilder.NewMessageBuilder().Text("only you").Ephemeral("U1")
+
+	endpoint, values, err := slack.UnsafeApplyMsgOptions("token", "C1", "https://slack.com/api/", message.Build()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if endpoint != "https://slack.com/api/chat.postEphemeral" || values.Get("user") != "U1" || values.Get("text") != "only you" {
+		t.Errorf("endpoint = %q, values = %v", endpoint, values)
+	}
+}
+
+func TestMessageBuilderJSON(t *testing.T) {
+	data, err := blockbuilder.NewMessageBuilder().
+		Channel("C1").
+		Text("*hi*").
+		Blocks(blockbuilder.NewDividerBlock()).
+		UnfurlLinks(false).
+		JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload["channel"] != "C1" || payload["text"] != "*hi*" || payload["unfurl_links"] != false {
+		t.Errorf("payload = %s", data)
+	}
+	if blocks, _ := payload["blocks"].([]any); len(blocks) != 1 {
+		t.Errorf("blocks = %v", payload["blocks"])
+	}
+	if _, ok := payload["unfurl_media"]; ok {
+		t.Errorf("unfurl_media set without UnfurlMedia: %s", data)
+	}
+}
+
+func TestMessageBuilderValidate(t *testing.T) {
+	err := blockbuilder.NewMessageBuilder().
+		Text(strings.Repeat("a", blockbuilder.MaxMessageTextLength+1)).
+		Broadcast().
+		Metadata("", nil).
+		Validate()
+
+	var errs blockbuilder.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Fatalf("Validate = %v, want 3 errors", err)
+	}
+
+	if err := blockbuilder.NewMessageBuilder().Validate(); err == nil {
+		t.Error("empty message passed validation")
+	}
+}
+
+func TestMessageBuilderValidateAttachments(t *testing.T) {
+	err := blockbuilder.NewMessageBuilder().
+		Text("deploy").
+		Attachments(slack.Attachment{Text: "ok"}, slack.Attachment{TitleLink: "https://example.com"}).
+		AttachmentFrom(blockbuilder.NewAttachmentBuilder("").AddSection("", true)).
+		Validate()
+
+	var errs blockbuilder.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("Validate = %v, want 2 errors", err)
+	}
+	for i, want := range []string{"attachment 1: title_link requires title", "attachment 2: block 0: section block requires text or fields"} {
+		if errs[i].BlockIndex != -1 || errs[i].Message != want {
+			t.Errorf("error %d = %+v, want %q", i, errs[i], want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package blockbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+)
+
+// EncodePrivateMetadata encodes v as JSON for a view's private_metadata.
+// It returns an error if the encoding exceeds MaxPrivateMetadataLength characters.
+func EncodePrivateMetadata(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode private_metadata: %w", err)
+	}
+	if n := utf8.RuneCount(data); n > MaxPrivateMetadataLength {
+		return "", fmt.Errorf("private_metadata is %d characters (max %d)", n, MaxPrivateMetadataLength)
+	}
+	return string(data), nil
+}
+
+// DecodePrivateMetadata decodes private_metadata written by EncodePrivateMetadata,
+// for example from the View of an interaction callback.
+func DecodePrivateMetadata[T any](metadata string) (T, error) {
+	var v T
+	if metadata == "" {
+		return v, nil
+	}
+	if err := json.Unmarshal([]byte(metadata), &v); err != nil {
+		return v, fmt.Errorf("failed to decode private_metadata: %w", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,146 @@
+package blockbuilder
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// RichTextBuilder builds a rich text block from paragraphs, lists, quotes and preformatted text.
+type RichTextBuilder struct {
+	block *slack.RichTextBlock
+}
+
+// NewRichText initializes an empty RichTextBuilder.
+func NewRichText() *RichTextBuilder {
+	return &RichTextBuilder{
+		block: NewRichTextBlock(),
+	}
+}
+
+// Paragraph adds a paragraph made of spans such as RichTextSpan, RichTextBold and RichTextLink.
+func (r *RichTextBuilder) Paragraph(spans ...slack.RichTextSectionElement) *RichTextBuilder {
+	return r.add(slack.NewRichTextSection(spans...))
+}
+
+// BulletList adds a bulleted list. Create its items with RichTextItem.
+func (r *RichTextBuilder) BulletList(items ...*slack.RichTextSection) *RichTextBuilder {
+	return r.List(slack.RTEListBullet, 0, items...)
+}
+
+// OrderedList adds a numbered list. Create its items with RichTextItem.
+func (r *RichTextBuilder) OrderedList(items ...*slack.RichTextSection) *RichTextBuilder {
+	return r.List(slack.RTEListOrdered, 0, items...)
+}
+
+// List adds a list with the given style and indent. Slack renders nested lists as consecutive
+// lists with increasing indent, so add a sublist right after the item it belongs under.
+func (r *RichTextBuilder) List(style slack.RichTextListElementType, indent int, items ...*slack.RichTextSection) *RichTextBuilder {
+	elements := make([]slack.RichTextElement, len(items))
+	for i, item := range items {
+		elements[i] = item
+	}
+	return r.add(slack.NewRichTextList(style, indent, elements...))
+}
+
+// Quote adds a block quote made of spans.
+func (r *RichTextBuilder) Quote(spans ...slack.RichTextSectionElement) *RichTextBuilder {
+	return r.add(&slack.RichTextQuote{Type: slack.RTEQuote, Elements: spans})
+}
+
+// Preformatted adds a preformatted code block.
+func (r *RichTextBuilder) Preformatted(text string) *RichTextBuilder {
+	return r.add(&slack.RichTextPreformatted{
+		RichTextSection: slack.RichTextSection{
+			Type:     slack.RTEPreformatted,
+			Elements: []slack.RichTextSectionElement{RichTextSpan(text)},
+		},
+	})
+}
+
+// BlockID sets the rich text block's block_id.
+func (r *RichTextBuilder) BlockID(blockID string) *RichTextBuilder {
+	r.block.BlockID = blockID
+	return r
+}
+
+// Build returns the rich text block.
+func (r *RichTextBuilder) Build() *slack.RichTextBlock {
+	return r.block
+}
+
+// add appends a top-level element to the block.
+func (r *RichTextBuilder) add(element slack.RichTextElement) *RichTextBuilder {
+	r.block.Elements = append(r.block.Elements, element)
+	return r
+}
+
+// AddRichTextFrom adds a rich text block built with a RichTextBuilder.
+func (b *BlockBuilder) AddRichTextFrom(richText *RichTextBuilder) *BlockBuilder {
+	b.blocks = append(b.blocks, richText.Build())
+	return b
+}
+
+// AddRichTextFrom adds a rich text block built with a RichTextBuilder to the attachment.
+func (a *AttachmentBuilder) AddRichTextFrom(richText *RichTextBuilder) *AttachmentBuilder {
+	a.attachment.Blocks.BlockSet = append(a.attachment.Blocks.BlockSet, richText.Build())
+	return a
+}
+
+// RichTextItem creates a list item made of spans, for BulletList, OrderedList and List.
+func RichTextItem(spans ...slack.RichTextSectionElement) *slack.RichTextSection {
+	return slack.NewRichTextSection(spans...)
+}
+
+// RichTextSpan creates an unstyled rich text span.
+func RichTextSpan(text string) *slack.RichTextSectionTextElement {
+	return slack.NewRichTextSectionTextElement(text, nil)
+}
+
+// RichTextBold creates a bold rich text span.
+func RichTextBold(text string) *slack.RichTextSectionTextElement {
+	return RichTextStyled(text, slack.RichTextSectionTextStyle{Bold: true})
+}
+
+// RichTextItalic creates an italic rich text span.
+func RichTextItalic(text string) *slack.RichTextSectionTextElement {
+	return RichTextStyled(text, slack.RichTextSectionTextStyle{Italic: true})
+}
+
+// RichTextStrike creates a struck-through rich text span.
+func RichTextStrike(text string) *slack.RichTextSectionTextElement {
+	return RichTextStyled(text, slack.RichTextSectionTextStyle{Strike: true})
+}
+
+// RichTextCode creates an inline code span.
+func RichTextCode(text string) *slack.RichTextSectionTextElement {
+	return RichTextStyled(text, slack.RichTextSectionTextStyle{Code: true})
+}
+
+// RichTextStyled creates a rich text span with any combination of styles.
+func RichTextStyled(text string, style slack.RichTextSectionTextStyle) *slack.RichTextSectionTextElement {
+	return slack.NewRichTextSectionTextElement(text, &style)
+}
+
+// RichTextLink creates a link span. An empty text shows the URL.
+func RichTextLink(url, text string) *slack.RichTextSectionLinkElement {
+	return slack.NewRichTextSectionLinkElement(url, text, nil)
+}
+
+// RichTextUser creates a span mentioning a user.
+func RichTextUser(userID string) *slack.RichTextSectionUserElement {
+	return slack.NewRichTextSectionUserElement(userID, nil)
+}
+
+// RichTextChannel creates a span linking to a channel.
+func RichTextChannel(channelID string) *slack.RichTextSectionChannelElement {
+	return slack.NewRichTextSectionChannelElement(channelID, nil)
+}
+
+// RichTextUserGroup creates a span mentioning a user group.
+func RichTextUserGroup(usergroupID string) *slack.RichTextSectionUserGroupElement {
+	return slack.NewRichTextSectionUserGroupElement(usergroupID)
+}
+
+// RichTextEmoji creates an emoji span from its name without colons, such as "rocket".
+func RichTextEmoji(name string) *slack.RichTextSectionEmojiElement {
+	return slack.NewRichTextSectionEmojiElement(name, 0, nil)
+}
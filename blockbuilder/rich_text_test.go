@@ -0,0 +1,41 @@
+package blockbuilder_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestRichTextBuilder(t *testing.T) {
+	rt := blockbuilder.NewRichText().
+		Paragraph(blockbuilder.RichTextSpan("Deploy "), blockbuilder.RichTextBold("failed"), blockbuilder.RichTextSpan(" for "), blockbuilder.RichTextUser("U123")).
+		BulletList(blockbuilder.RichTextItem(blockbuilder.RichTextCode("api")), blockbuilder.RichTextItem(blockbuilder.RichTextLink("https://example.com", "worker"))).
+		List(slack.RTEListOrdered, 1, blockbuilder.RichTextItem(blockbuilder.RichTextSpan("nested"))).
+		Quote(blockbuilder.RichTextItalic("Roll back first.")).
+		Preformatted("kubectl rollout undo deploy/api")
+
+	blocks := blockbuilder.NewBlockBuilder().AddRichTextFrom(rt).Build()
+	data, err := json.Marshal(blocks[0])
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var decoded slack.RichTextBlock
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(decoded.Elements) != 5 {
+		t.Fatalf("expected 5 elements, got %d: %s", len(decoded.Elements), data)
+	}
+	wantTypes := []slack.RichTextElementType{slack.RTESection, slack.RTEList, slack.RTEList, slack.RTEQuote, slack.RTEPreformatted}
+	for i, want := range wantTypes {
+		if got := decoded.Elements[i].RichTextElementType(); got != want {
+			t.Errorf("element %d: expected %s, got %s", i, want, got)
+		}
+	}
+	if nested := decoded.Elements[2].(*slack.RichTextList); nested.Indent != 1 || nested.Style != slack.RTEListOrdered {
+		t.Errorf("unexpected nested list: %+v", nested)
+	}
+}
@@ -0,0 +1,162 @@
+package blockbuilder
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/mrkdwn"
+	"github.com/slack-go/slack"
+)
+
+// Field is a label/value pair rendered as a two-line section field.
+type Field struct {
+	Label string
+	Value string
+}
+
+// SectionBuilder builds a section block with text, fields and an accessory.
+type SectionBuilder struct {
+	section   *slack.SectionBlock
+	accessory slack.BlockElement
+}
+
+// AccessorySection is a section block whose accessory is encoded as given, keeping what
+// slack.Accessory cannot hold: an AccessibleButton's label, or an unsupported element for
+// Validate to report. BlockBuilder and AttachmentBuilder hold one instead of a
+// *slack.SectionBlock only for sections added with AddSectionFrom that have such an
+// accessory. Use SectionOf to inspect sections of either type.
+type AccessorySection struct {
+	*slack.SectionBlock
+	Accessory slack.BlockElement `json:"accessory,omitempty"`
+}
+
+// SectionOf returns the section block held by block: block itself if it is a
+// *slack.SectionBlock, or the section embedded in an *AccessorySection.
+func SectionOf(block slack.Block) (*slack.SectionBlock, bool) {
+	switch b := block.(type) {
+	case *slack.SectionBlock:
+		return b, true
+	case *AccessorySection:
+		return b.SectionBlock, true
+	default:
+		return nil, false
+	}
+}
+
+// NewSection initializes an empty SectionBuilder.
+func NewSection() *SectionBuilder {
+	return &SectionBuilder{
+		section: slack.NewSectionBlock(nil, nil, nil),
+	}
+}
+
+// Text sets the section's main text as mrkdwn. Text longer than MaxSectionTextLength is
+// truncated with an ellipsis; add long text with BlockBuilder.AddSection and Split instead.
+func (s *SectionBuilder) Text(text string) *SectionBuilder {
+	s.section.Text = slack.NewTextBlockObject("mrkdwn", truncateText(text, MaxSectionTextLength), false, false)
+	return s
+}
+
+// PlainText sets the section's main text as plain text, truncated like Text.
+func (s *SectionBuilder) PlainText(text string) *SectionBuilder {
+	s.section.Text = slack.NewTextBlockObject("plain_text", truncateText(text, MaxSectionTextLength), true, false)
+	return s
+}
+
+// Field adds a field showing a bold label above its value. Both are escaped, so they are
+// shown literally, and values that would exceed MaxSectionFieldLength are truncated.
+func (s *SectionBuilder) Field(label, value string) *SectionBuilder {
+	s.section.Fields = append(s.section.Fields, newField(label, value))
+	return s
+}
+
+// Fields adds several label/value fields in order.
+func (s *SectionBuilder) Fields(fields ...Field) *SectionBuilder {
+	for _, f := range fields {
+		s.Field(f.Label, f.Value)
+	}
+	return s
+}
+
+// Accessory sets the element shown beside the section text, such as a button, image,
+// overflow menu, select or date picker. Validate reports elements Slack does not allow as
+// accessories. The section returned by Build cannot hold such elements or an AccessibleButton's
+// accessibility label; BlockBuilder.AddSectionFrom keeps both.
+func (s *SectionBuilder) Accessory(element slack.BlockElement) *SectionBuilder {
+	s.accessory = element
+	s.section.Accessory = newAccessory(element)
+	return s
+}
+
+// BlockID sets the section's block_id.
+func (s *SectionBuilder) BlockID(blockID string) *SectionBuilder {
+	s.section.BlockID = blockID
+	return s
+}
+
+// Build returns the constructed section block.
+// Sections with more than MaxSectionFields fields should be added with BlockBuilder.AddSectionFrom,
+// which splits them across several sections.
+func (s *SectionBuilder) Build() *slack.SectionBlock {
+	return s.section
+}
+
+// Validate checks the section against Slack's limits, including whether its accessory is
+// supported. It returns nil or a ValidationErrors listing every violation.
+func (s *SectionBuilder) Validate() error {
+	return ValidateBlocks(s.blocks(), MaxMessageBlocks)
+}
+
+// blocks returns the section split to fit Slack's limits, keeping the accessory as given.
+func (s *SectionBuilder) blocks() []slack.Block {
+	blocks := splitSection(s.section)
+	if _, accessible := s.accessory.(*AccessibleButton); accessible || (s.accessory != nil && s.section.Accessory == nil) {
+		blocks[0] = &AccessorySection{SectionBlock: blocks[0].(*slack.SectionBlock), Accessory: s.accessory}
+	}
+	return blocks
+}
+
+// sortedFields converts a map to fields ordered by label.
+func sortedFields(fields map[string]string) []Field {
+	labels := make([]string, 0, len(fields))
+	for label := range fields {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	ordered := make([]Field, len(labels))
+	for i, label := range labels {
+		ordered[i] = Field{Label: label, Value: fields[label]}
+	}
+	return ordered
+}
+
+// newField renders a label/value pair as an escaped mrkdwn field within the field length limit.
+func newField(label, value string) *slack.TextBlockObject {
+	text := escapeTruncate(value, MaxSectionFieldLength)
+	if label != "" {
+		prefix := mrkdwn.Bold(label).String() + "\n"
+		if budget := MaxSectionFieldLength - utf8.RuneCountInString(prefix); budget > 0 {
+			text = prefix + escapeTruncate(value, budget)
+		} else {
+			text = escapeTruncate(label, MaxSectionFieldLength)
+		}
+	}
+	return slack.NewTextBlockObject("mrkdwn", text, false, false)
+}
+
+// newAccessory wraps an element in an Accessory, returning nil for elements that
+// slack.Accessory cannot encode.
+func newAccessory(element slack.BlockElement) *slack.Accessory {
+	switch e := element.(type) {
+	case *AccessibleButton:
+		return slack.NewAccessory(&e.ButtonBlockElement)
+	case *slack.ImageBlockElement, *slack.ButtonBlockElement, *slack.OverflowBlockElement,
+		*slack.DatePickerBlockElement, *slack.TimePickerBlockElement, *slack.PlainTextInputBlockElement,
+		*slack.RadioButtonsBlockElement, *slack.SelectBlockElement, *slack.MultiSelectBlockElement,
+		*slack.CheckboxGroupsBlockElement:
+		return slack.NewAccessory(element)
+	default:
+		return nil
+	}
+}
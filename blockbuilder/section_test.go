@@ -0,0 +1,133 @@
+package blockbuilder_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestSectionBuilder(t *testing.T) {
+	button := blockbuilder.NewButton("approve", "Approve", "yes")
+	section := blockbuilder.NewSection().
+		Text("*Deploy* requested").
+		Field("Service", "api").
+		Field("Version", strings.Repeat("x", 3000)).
+		Accessory(button).
+		BlockID("deploy").
+		Build()
+
+	if section.Text.Type != "mrkdwn" || section.Text.Text != "*Deploy* requested" {
+		t.Errorf("unexpected text: %+v", section.Text)
+	}
+	if len(section.Fields) != 2 || section.Fields[0].Text != "*Service*\napi" {
+		t.Fatalf("unexpected fields: %+v", section.Fields)
+	}
+	if n := utf8.RuneCountInString(section.Fields[1].Text); n != blockbuilder.MaxSectionFieldLength {
+		t.Errorf("expected long field truncated to %d characters, got %d", blockbuilder.MaxSectionFieldLength, n)
+	}
+	if section.Accessory == nil || section.Accessory.ButtonElement != button {
+		t.Errorf("expected button accessory, got %+v", section.Accessory)
+	}
+	if section.BlockID != "deploy" {
+		t.Errorf("expected block_id deploy, got %q", section.BlockID)
+	}
+}
+
+func TestAddFieldsSplitsSections(t *testing.T) {
+	var fields []blockbuilder.Field
+	for i := 0; i < 25; i++ {
+		fields = append(fields, blockbuilder.Field{Label: fmt.Sprintf("Key %d", i), Value: "value"})
+	}
+
+	builder := blockbuilder.NewBlockBuilder().AddFields(fields...)
+	blocks := builder.Build()
+	if len(blocks) != 3 {
+		t.Fatalf("expected 3 sections, got %d", len(blocks))
+	}
+	if last := blocks[2].(*slack.SectionBlock); last.Fields[4].Text != "*Key 24*\nvalue" {
+		t.Errorf("fields out of order: %q", last.Fields[4].Text)
+	}
+	if err := builder.Validate(); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+}
+
+func TestAddFieldsMapSortsLabels(t *testing.T) {
+	blocks := blockbuilder.NewBlockBuilder().
+		AddFieldsMap(map[string]string{"Zone": "us-east-1", "Account": "prod"}).
+		Build()
+
+	fields := blocks[0].(*slack.SectionBlock).Fields
+	if fields[0].Text != "*Account*\nprod" || fields[1].Text != "*Zone*\nus-east-1" {
+		t.Errorf("unexpected field order: %q, %q", fields[0].Text, fields[1].Text)
+	}
+}
+
+func TestSectionFieldsAreEscaped(t *testing.T) {
+	section := blockbuilder.NewSection().
+		Field("<!channel>", "a & b <@U123>").
+		Field("", strings.Repeat("&", 3000)).
+		Build()
+
+	if got, want := section.Fields[0].Text, "*&lt;!channel&gt;*\na &amp; b &lt;@U123&gt;"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	long := section.Fields[1].Text
+	if n := utf8.RuneCountInString(long); n > blockbuilder.MaxSectionFieldLength {
+		t.Errorf("expected field within %d characters, got %d", blockbuilder.MaxSectionFieldLength, n)
+	}
+	if trimmed := strings.TrimSuffix(long, "…"); strings.ReplaceAll(trimmed, "&amp;", "") != "" {
+		t.Errorf("expected truncation to keep whole entities, got suffix %q", trimmed[len(trimmed)-8:])
+	}
+}
+
+func TestSectionAccessoryKeptByAddSectionFrom(t *testing.T) {
+	button := blockbuilder.NewButtonBuilder("approve", "Approve").AccessibilityLabel("Approve the deploy").Build()
+	section := blockbuilder.NewSection().Text("Deploy").Accessory(button)
+
+	if err := section.Validate(); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+	data, err := json.Marshal(blockbuilder.NewBlockBuilder().AddSectionFrom(section).Build())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"accessibility_label":"Approve the deploy"`) {
+		t.Errorf("expected accessibility label in %s", data)
+	}
+	if strings.Count(string(data), `"accessory"`) != 1 {
+		t.Errorf("expected a single accessory in %s", data)
+	}
+
+	blocks := blockbuilder.NewBlockBuilder().AddSectionFrom(section).Build()
+	if _, ok := blocks[0].(*blockbuilder.AccessorySection); !ok {
+		t.Fatalf("expected an *AccessorySection, got %T", blocks[0])
+	}
+	if got, ok := blockbuilder.SectionOf(blocks[0]); !ok || got.Text.Text != "Deploy" {
+		t.Errorf("SectionOf = %+v, %v", got, ok)
+	}
+	if _, ok := blockbuilder.SectionOf(slack.NewDividerBlock()); ok {
+		t.Errorf("SectionOf reported a section for a divider")
+	}
+}
+
+func TestSectionValidateReportsUnsupportedAccessory(t *testing.T) {
+	input := &slack.EmailTextInputBlockElement{Type: slack.METEmailTextInput, ActionID: "email"}
+	section := blockbuilder.NewSection().Text("Contact").Accessory(input)
+
+	if section.Build().Accessory != nil {
+		t.Errorf("expected Build to leave out the unsupported accessory")
+	}
+	err := section.Validate()
+	if err == nil || !strings.Contains(err.Error(), "accessory") {
+		t.Errorf("expected unsupported accessory error, got %v", err)
+	}
+	if err := blockbuilder.NewBlockBuilder().AddSectionFrom(section).Validate(); err == nil {
+		t.Errorf("expected BlockBuilder.Validate to report the unsupported accessory")
+	}
+}
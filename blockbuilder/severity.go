@@ -0,0 +1,65 @@
+package blockbuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity is the level of an alert, from SeverityInfo to SeverityCritical.
+type Severity int
+
+// Severity levels in increasing order.
+const (
+	SeverityInfo Severity = iota
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+// severityNames are the names of the severity levels, indexed by level.
+var severityNames = []string{"info", "low", "medium", "high", "critical"}
+
+// severityColors are the colors of the severity levels, indexed by level.
+var severityColors = []Color{
+	MustParseColor(ColorInfo),
+	MustParseColor(ColorGood),
+	MustParseColor(ColorWarning),
+	MustParseColor("#ff6633"),
+	MustParseColor(ColorDanger),
+}
+
+// String returns the name of the level, such as "critical".
+func (s Severity) String() string {
+	if s < SeverityInfo || s > SeverityCritical {
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// ParseSeverity parses the name of a severity level, ignoring case.
+func ParseSeverity(name string) (Severity, error) {
+	for i, n := range severityNames {
+		if strings.EqualFold(name, n) {
+			return Severity(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown severity %q", name)
+}
+
+// SeverityColor returns the color of a severity level: blue for info, green, orange and
+// orange-red for low to high, and red for critical. Levels out of range are clamped.
+func SeverityColor(level Severity) Color {
+	level = max(SeverityInfo, min(level, SeverityCritical))
+	return severityColors[level]
+}
+
+// ScoreColor returns a color for a score from 0 to 100 on a gradient from green through
+// orange to red, e.g. for risk or error rate scores.
+func ScoreColor(score float64) Color {
+	return Gradient(score/100,
+		SeverityColor(SeverityLow),
+		SeverityColor(SeverityMedium),
+		SeverityColor(SeverityCritical),
+	)
+}
@@ -0,0 +1,394 @@
+package blockbuilder
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+)
+
+// codeFence opens and closes preformatted text in Slack mrkdwn.
+const codeFence = "```"
+
+// splitConfig holds the settings applied by SplitOption.
+type splitConfig struct {
+	maxBlocks    int
+	repeatHeader bool
+	repeatFooter bool
+}
+
+// SplitOption defines a functional option for Split.
+type SplitOption func(*splitConfig)
+
+// SplitOptionMaxBlocks sets the maximum number of blocks per page (MaxMessageBlocks by default).
+func SplitOptionMaxBlocks(maxBlocks int) SplitOption {
+	return func(c *splitConfig) {
+		c.maxBlocks = maxBlocks
+	}
+}
+
+// SplitOptionRepeatHeader repeats the leading header and context blocks at the top of every page.
+func SplitOptionRepeatHeader() SplitOption {
+	return func(c *splitConfig) {
+		c.repeatHeader = true
+	}
+}
+
+// SplitOptionRepeatFooter repeats the trailing context blocks at the bottom of every page.
+func SplitOptionRepeatFooter() SplitOption {
+	return func(c *splitConfig) {
+		c.repeatFooter = true
+	}
+}
+
+// Paginate splits the assembled blocks into pages that each fit in one message.
+// See Split for details.
+func (b *BlockBuilder) Paginate(opts ...SplitOption) [][]slack.Block {
+	return Split(b.blocks, opts...)
+}
+
+// Split partitions blocks into pages that each respect Slack's message limits, ready to be
+// posted as a series of messages (for example, replies in a thread).
+//
+// Sections whose text exceeds MaxSectionTextLength are split at line boundaries without
+// breaking <...> entities such as links and mentions, and code fences are closed and reopened
+// across the split. Sections with more than MaxSectionFields fields and actions blocks with
+// more than MaxActionElements elements are divided into several blocks. The input is not modified.
+func Split(blocks []slack.Block, opts ...SplitOption) [][]slack.Block {
+	cfg := splitConfig{maxBlocks: MaxMessageBlocks}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.maxBlocks < 1 {
+		cfg.maxBlocks = 1
+	}
+
+	// Break oversized blocks into several valid ones
+	var normalized []slack.Block
+	for _, block := range blocks {
+		normalized = append(normalized, splitBlock(block)...)
+	}
+
+	// Separate the repeated header and footer from the content
+	var header, footer []slack.Block
+	content := normalized
+	if cfg.repeatHeader {
+		n := 0
+		for n < len(content) && isHeaderBlock(content[n]) {
+			n++
+		}
+		header, content = content[:n], content[n:]
+	}
+	if cfg.repeatFooter {
+		n := len(content)
+		for n > 0 && isContextBlock(content[n-1]) {
+			n--
+		}
+		content, footer = content[:n], content[n:]
+	}
+
+	// Repeat the header and footer only while they leave room for content
+	perPage := cfg.maxBlocks - len(header) - len(footer)
+	if perPage < 1 {
+		header, footer = nil, nil
+		content = normalized
+		perPage = cfg.maxBlocks
+	}
+
+	if len(content) <= perPage {
+		return [][]slack.Block{append(append(append([]slack.Block{}, header...), content...), footer...)}
+	}
+
+	var pages [][]slack.Block
+	for start := 0; start < len(content); start += perPage {
+		end := min(start+perPage, len(content))
+		page := make([]slack.Block, 0, len(header)+end-start+len(footer))
+		page = append(page, header...)
+		page = append(page, content[start:end]...)
+		page = append(page, footer...)
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// splitBlock divides a block that exceeds per-block limits into several blocks.
+func splitBlock(block slack.Block) []slack.Block {
+	switch b := block.(type) {
+	case *slack.SectionBlock:
+		return splitSection(b)
+	case *AccessorySection:
+		blocks := splitSection(b.SectionBlock)
+		blocks[0] = &AccessorySection{SectionBlock: blocks[0].(*slack.SectionBlock), Accessory: b.Accessory}
+		return blocks
+	case *slack.ActionBlock:
+		if b.Elements == nil || len(b.Elements.ElementSet) <= MaxActionElements {
+			return []slack.Block{b}
+		}
+		var out []slack.Block
+		for i, elements := range chunk(b.Elements.ElementSet, MaxActionElements) {
+			actions := slack.NewActionBlock("", elements...)
+			if i == 0 {
+				actions.BlockID = b.BlockID
+			}
+			out = append(out, actions)
+		}
+		return out
+	default:
+		return []slack.Block{block}
+	}
+}
+
+// splitSection divides a section with oversized text or too many fields.
+// The block_id and accessory stay on the first section.
+func splitSection(b *slack.SectionBlock) []slack.Block {
+	var texts []string
+	if b.Text != nil && utf8.RuneCountInString(b.Text.Text) > MaxSectionTextLength {
+		texts = SplitText(b.Text.Text, MaxSectionTextLength)
+	}
+	if len(texts) == 0 && len(b.Fields) <= MaxSectionFields {
+		return []slack.Block{b}
+	}
+
+	var out []*slack.SectionBlock
+	for _, text := range texts {
+		section := *b
+		textObj := *b.Text
+		textObj.Text = text
+		section.Text = &textObj
+		section.Fields = nil
+		out = append(out, &section)
+	}
+	if len(texts) == 0 {
+		section := *b
+		section.Fields = nil
+		out = append(out, &section)
+	}
+
+	// Fields fill the last text section, then further sections without text
+	for i, fields := range chunk(b.Fields, MaxSectionFields) {
+		if i == 0 {
+			out[len(out)-1].Fields = fields
+			continue
+		}
+		out = append(out, &slack.SectionBlock{Type: slack.MBTSection, Fields: fields})
+	}
+
+	blocks := make([]slack.Block, len(out))
+	for i, section := range out {
+		if i > 0 {
+			section.BlockID = ""
+			section.Accessory = nil
+		}
+		blocks[i] = section
+	}
+	return blocks
+}
+
+// MinSplitTextLimit is the smallest limit SplitText honours, leaving room to close and
+// reopen a code fence around an &amp; escape.
+const MinSplitTextLimit = 16
+
+// SplitText splits mrkdwn text into chunks of at most limit characters.
+// It prefers line boundaries, then spaces, never splits inside a <...> entity unless
+// the entity alone exceeds the limit, never splits an &...; escape, and closes and reopens
+// code fences across chunks. Limits below MinSplitTextLimit are raised to it.
+func SplitText(text string, limit int) []string {
+	limit = max(limit, MinSplitTextLimit)
+	if utf8.RuneCountInString(text) <= limit {
+		return []string{text}
+	}
+
+	// Leave room to close an open code fence at the end of a chunk
+	reserve := len("\n" + codeFence)
+	budget := limit - reserve
+	if budget < 1 {
+		budget, reserve = limit, 0
+	}
+
+	var (
+		chunks  []string
+		current strings.Builder
+		length  int
+		inFence bool
+	)
+	flush := func() {
+		chunk := strings.TrimRight(current.String(), " \n")
+		if inFence {
+			chunk += "\n" + codeFence
+		}
+		// Drop chunks that are empty or hold nothing but fences
+		if strings.TrimSpace(strings.ReplaceAll(chunk, codeFence, "")) != "" {
+			chunks = append(chunks, chunk)
+		}
+		current.Reset()
+		length = 0
+		if inFence {
+			current.WriteString(codeFence + "\n")
+			length = len(codeFence) + 1
+		}
+	}
+	write := func(s string) {
+		current.WriteString(s)
+		length += utf8.RuneCountInString(s)
+		if strings.Count(s, codeFence)%2 == 1 {
+			inFence = !inFence
+		}
+	}
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		lineLength := utf8.RuneCountInString(line)
+		togglesFence := strings.Count(line, codeFence)%2 == 1
+
+		// A closing fence may use the room reserved for closing the fence
+		lineBudget := budget
+		if inFence && togglesFence {
+			lineBudget += reserve
+		}
+		if length+lineLength > lineBudget && length > 0 {
+			flush()
+		}
+		if length+lineLength > lineBudget {
+			// Split against the room left, which shrinks when a flush reopens a fence
+			for rest := line; rest != ""; {
+				piece := linePiece(rest, budget-length)
+				write(piece)
+				if rest = rest[len(piece):]; rest != "" {
+					flush()
+				}
+			}
+		} else {
+			write(line)
+		}
+	}
+	inFence = false
+	flush()
+
+	return chunks
+}
+
+// linePiece returns the longest leading piece of line with at most limit characters,
+// cut at a break point.
+func linePiece(line string, limit int) string {
+	if limit < 1 {
+		limit = 1
+	}
+	runes := []rune(line)
+	if len(runes) <= limit {
+		return line
+	}
+	return string(runes[:breakPoint(runes, limit)])
+}
+
+// breakPoint returns where to cut runes so the first piece has at most limit characters,
+// preferring the last space outside a <...> entity, never cutting inside an entity that
+// starts after the beginning of the line, and never cutting an &...; escape or a run of
+// backticks such as a code fence.
+func breakPoint(runes []rune, limit int) int {
+	cut := entityBreakPoint(runes, limit)
+	if start, end := escapeAround(runes, cut); start > 0 {
+		cut = start
+	} else if start == 0 {
+		// The escape starts the line; keep it whole in the first piece
+		cut = end
+	}
+	for i := cut; i > 0 && runes[i-1] == '`' && runes[i] == '`'; i-- {
+		cut = i - 1
+	}
+	if cut == 0 {
+		// The run starts the line; keep it whole in the first piece
+		for cut < len(runes) && runes[cut] == '`' {
+			cut++
+		}
+	}
+	return cut
+}
+
+// entityBreakPoint returns where to cut runes so the first piece has at most limit
+// characters, preferring the last space outside a <...> entity.
+func entityBreakPoint(runes []rune, limit int) int {
+	lastSpace, entityStart := -1, -1
+	for i := 0; i < limit; i++ {
+		switch runes[i] {
+		case '<':
+			entityStart = i
+		case '>':
+			entityStart = -1
+		case ' ':
+			if entityStart < 0 {
+				lastSpace = i + 1
+			}
+		}
+	}
+	switch {
+	case lastSpace > 0:
+		return lastSpace
+	case entityStart > 0:
+		return entityStart
+	default:
+		return limit
+	}
+}
+
+// maxEscapeLength is the length of the longest &...; escape looked for around a cut.
+const maxEscapeLength = 8
+
+// escapeAround returns the bounds of the &...; escape that a cut at index would split,
+// or -1, -1 if it would split none.
+func escapeAround(runes []rune, index int) (start, end int) {
+	start = -1
+	for i := index - 1; i >= 0 && i > index-maxEscapeLength; i-- {
+		if runes[i] == '&' {
+			start = i
+			break
+		}
+		if !isEscapeRune(runes[i]) {
+			return -1, -1
+		}
+	}
+	if start < 0 {
+		return -1, -1
+	}
+	for i := index; i < len(runes) && i < start+maxEscapeLength; i++ {
+		if runes[i] == ';' {
+			return start, i + 1
+		}
+		if !isEscapeRune(runes[i]) {
+			break
+		}
+	}
+	return -1, -1
+}
+
+// isEscapeRune reports whether r may appear between the & and ; of an escape.
+func isEscapeRune(r rune) bool {
+	return r == '#' || r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// isHeaderBlock reports whether block is a header or context block.
+func isHeaderBlock(block slack.Block) bool {
+	switch block.(type) {
+	case *slack.HeaderBlock, *slack.ContextBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+// isContextBlock reports whether block is a context block.
+func isContextBlock(block slack.Block) bool {
+	_, ok := block.(*slack.ContextBlock)
+	return ok
+}
+
+// chunk splits items into consecutive slices of at most size elements.
+func chunk[T any](items []T, size int) [][]T {
+	var chunks [][]T
+	for size < len(items) {
+		items, chunks = items[size:], append(chunks, items[:size:size])
+	}
+	if len(items) > 0 {
+		chunks = append(chunks, items)
+	}
+	return chunks
+}
@@ -0,0 +1,134 @@
+package blockbuilder_test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestPaginateRepeatsHeader(t *testing.T) {
+	builder := blockbuilder.NewBlockBuilder().
+		AddContext(slack.NewTextBlockObject("mrkdwn", "*Daily report*", false, false))
+	for i := 0; i < 120; i++ {
+		builder.AddSection(fmt.Sprintf("Item %d", i), true)
+	}
+
+	pages := builder.Paginate(blockbuilder.SplitOptionRepeatHeader())
+	if len(pages) != 3 {
+		t.Fatalf("expected 3 pages, got %d", len(pages))
+	}
+	for i, page := range pages {
+		if len(page) > blockbuilder.MaxMessageBlocks {
+			t.Errorf("page %d has %d blocks", i, len(page))
+		}
+		if _, ok := page[0].(*slack.ContextBlock); !ok {
+			t.Errorf("page %d does not start with the repeated header", i)
+		}
+		if err := blockbuilder.ValidateBlocks(page, blockbuilder.MaxMessageBlocks); err != nil {
+			t.Errorf("page %d is invalid: %v", i, err)
+		}
+	}
+}
+
+func TestSplitLongSection(t *testing.T) {
+	var lines []string
+	for i := 0; i < 400; i++ {
+		lines = append(lines, fmt.Sprintf("line %d with a link <https://example.com/%d|example>", i, i))
+	}
+	text := strings.Join(lines, "\n")
+
+	pages := blockbuilder.Split([]slack.Block{blockbuilder.NewSectionBlock(text, true)})
+	if len(pages) != 1 {
+		t.Fatalf("expected 1 page, got %d", len(pages))
+	}
+
+	var joined []string
+	for _, block := range pages[0] {
+		section := block.(*slack.SectionBlock)
+		if n := utf8.RuneCountInString(section.Text.Text); n > blockbuilder.MaxSectionTextLength {
+			t.Errorf("section text is %d characters", n)
+		}
+		joined = append(joined, section.Text.Text)
+	}
+	if strings.Join(joined, "\n") != text {
+		t.Errorf("split text does not rejoin to the original at line boundaries")
+	}
+}
+
+func TestSplitTextKeepsEntitiesAndFences(t *testing.T) {
+	text := "```\n" + strings.Repeat("x", 30) + "\n" + strings.Repeat("y", 30) + "\n```\nsee the runbook at <https://ex.com/d|the docs> today"
+
+	chunks := blockbuilder.SplitText(text, 40)
+	for i, c := range chunks {
+		if utf8.RuneCountInString(c) > 40 {
+			t.Errorf("chunk %d is too long: %q", i, c)
+		}
+		if strings.Count(c, "```")%2 != 0 {
+			t.Errorf("chunk %d has an unbalanced code fence: %q", i, c)
+		}
+		if strings.Count(c, "<") != strings.Count(c, ">") {
+			t.Errorf("chunk %d splits an entity: %q", i, c)
+		}
+	}
+}
+
+func TestSplitTextKeepsEscapes(t *testing.T) {
+	texts := []string{
+		strings.Repeat("x&amp;", 30),
+		strings.Repeat("ab&lt;&gt;", 20),
+		"```" + strings.Repeat("y&amp;", 20) + "```",
+	}
+	escape := regexp.MustCompile(`&(amp|lt|gt);`)
+	for _, text := range texts {
+		for limit := blockbuilder.MinSplitTextLimit; limit <= 40; limit++ {
+			chunks := blockbuilder.SplitText(text, limit)
+			for i, c := range chunks {
+				if utf8.RuneCountInString(c) > limit {
+					t.Errorf("limit %d: chunk %d is too long: %q", limit, i, c)
+				}
+				if rest := escape.ReplaceAllString(c, ""); strings.ContainsAny(rest, "&;") {
+					t.Errorf("limit %d: chunk %d cuts an escape: %q", limit, i, c)
+				}
+			}
+		}
+	}
+	text := strings.Repeat("word ", 10)
+	if got, want := blockbuilder.SplitText(text, 1), blockbuilder.SplitText(text, blockbuilder.MinSplitTextLimit); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected a tiny limit to be raised to MinSplitTextLimit, got %q", got)
+	}
+}
+
+func TestSplitTextFenceEdgeCases(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+	}{
+		{"fence after text", "bar ```" + strings.Repeat("code ", 12) + "```\n" + strings.Repeat("after ", 10)},
+		{"fence at cut", strings.Repeat("a", 25) + " " + strings.Repeat("b", 8) + "```code```" + strings.Repeat(" c", 20)},
+		{"fence at boundary", strings.Repeat("word ", 7) + "\n```\n" + strings.Repeat("z", 20) + "\n```\n" + strings.Repeat("tail ", 8)},
+	}
+	for _, tt := range tests {
+		for limit := blockbuilder.MinSplitTextLimit; limit <= 45; limit++ {
+			for i, c := range blockbuilder.SplitText(tt.text, limit) {
+				if utf8.RuneCountInString(c) > limit {
+					t.Errorf("%s, limit %d: chunk %d is too long: %q", tt.name, limit, i, c)
+				}
+				if strings.Count(c, "```")%2 != 0 {
+					t.Errorf("%s, limit %d: chunk %d has an unbalanced code fence: %q", tt.name, limit, i, c)
+				}
+				if strings.Contains(strings.ReplaceAll(c, "```", ""), "`") {
+					t.Errorf("%s, limit %d: chunk %d cuts a fence: %q", tt.name, limit, i, c)
+				}
+				if strings.TrimSpace(strings.ReplaceAll(c, "```", "")) == "" {
+					t.Errorf("%s, limit %d: chunk %d is an empty code block: %q", tt.name, limit, i, c)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package blockbuilder
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+)
+
+// InputValidator checks the values submitted for one input block and returns an error whose
+// message is shown to the user below the input. Most validators accept an empty submission;
+// combine them with Required for mandatory inputs.
+type InputValidator func(values []string) error
+
+// Required rejects inputs left empty.
+func Required() InputValidator {
+	return func(values []string) error {
+		if len(values) == 0 {
+			return errors.New("This field is required.")
+		}
+		return nil
+	}
+}
+
+// MatchPattern rejects values that do not match re, showing message.
+func MatchPattern(re *regexp.Regexp, message string) InputValidator {
+	return func(values []string) error {
+		for _, value := range values {
+			if !re.MatchString(value) {
+				return errors.New(message)
+			}
+		}
+		return nil
+	}
+}
+
+// Length rejects values shorter than minLength or longer than maxLength characters.
+// Zero leaves a bound unchecked.
+func Length(minLength, maxLength int) InputValidator {
+	return func(values []string) error {
+		for _, value := range values {
+			n := utf8.RuneCountInString(value)
+			if n < minLength {
+				return fmt.Errorf("Must be at least %d characters.", minLength)
+			}
+			if maxLength > 0 && n > maxLength {
+				return fmt.Errorf("Must be at most %d characters.", maxLength)
+			}
+		}
+		return nil
+	}
+}
+
+// NumberRange rejects values that are not numbers between minValue and maxValue inclusive.
+// Pass math.Inf(-1) or math.Inf(1) to leave a bound unchecked.
+func NumberRange(minValue, maxValue float64) InputValidator {
+	return func(values []string) error {
+		for _, value := range values {
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return errors.New("Must be a number.")
+			}
+			switch {
+			case n >= minValue && n <= maxValue:
+			case math.IsInf(maxValue, 1):
+				return fmt.Errorf("Must be at least %s.", formatNumber(minValue))
+			case math.IsInf(minValue, -1):
+				return fmt.Errorf("Must be at most %s.", formatNumber(maxValue))
+			default:
+				return fmt.Errorf("Must be between %s and %s.", formatNumber(minValue), formatNumber(maxValue))
+			}
+		}
+		return nil
+	}
+}
+
+// FutureDate rejects dates from a date picker, sent as YYYY-MM-DD, that are not after today
+// in loc. now supplies the current time; nil means time.Now, and a nil loc means time.Local.
+func FutureDate(now func() time.Time, loc *time.Location) InputValidator {
+	if now == nil {
+		now = time.Now
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+	return func(values []string) error {
+		today := now().In(loc).Format(time.DateOnly)
+		for _, value := range values {
+			if _, err := time.Parse(time.DateOnly, value); err != nil {
+				return errors.New("Must be a date.")
+			}
+			if value <= today {
+				return errors.New("Must be a date after today.")
+			}
+		}
+		return nil
+	}
+}
+
+// FutureDateTime rejects times from a datetime picker, sent as Unix seconds, that are not after
+// now(). A nil now means time.Now.
+func FutureDateTime(now func() time.Time) InputValidator {
+	if now == nil {
+		now = time.Now
+	}
+	return func(values []string) error {
+		for _, value := range values {
+			sec, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return errors.New("Must be a date and time.")
+			}
+			if !time.Unix(sec, 0).After(now()) {
+				return errors.New("Must be in the future.")
+			}
+		}
+		return nil
+	}
+}
+
+// SubmissionValidator runs validators against a modal's submitted state and produces the
+// response_action errors payload Slack shows next to the offending inputs.
+type SubmissionValidator struct {
+	blockIDs   []string
+	validators map[string][]InputValidator
+}
+
+// NewSubmissionValidator initializes an empty SubmissionValidator.
+func NewSubmissionValidator() *SubmissionValidator {
+	return &SubmissionValidator{
+		validators: make(map[string][]InputValidator),
+	}
+}
+
+// Input registers validators for the input block with blockID. They run in order and the
+// first failure is reported.
+func (s *SubmissionValidator) Input(blockID string, validators ...InputValidator) *SubmissionValidator {
+	if _, ok := s.validators[blockID]; !ok {
+		s.blockIDs = append(s.blockIDs, blockID)
+	}
+	s.validators[blockID] = append(s.validators[blockID], validators...)
+	return s
+}
+
+// Validate runs the validators against state and returns error messages keyed by block_id.
+// The map is empty when every input is valid.
+func (s *SubmissionValidator) Validate(state *slack.ViewState) map[string]string {
+	errs := make(map[string]string)
+	for _, blockID := range s.blockIDs {
+		var values []string
+		if state != nil {
+			values = blockValues(state.Values[blockID])
+		}
+		for _, validate := range s.validators[blockID] {
+			if err := validate(values); err != nil {
+				errs[blockID] = err.Error()
+				break
+			}
+		}
+	}
+	return errs
+}
+
+// Response validates state and returns the errors response to pass to client.Ack,
+// or nil if every input is valid.
+//
+//	if resp := validator.Response(callback.View.State); resp != nil {
+//		client.Ack(*evt.Request, resp)
+//		return
+//	}
+func (s *SubmissionValidator) Response(state *slack.ViewState) *slack.ViewSubmissionResponse {
+	errs := s.Validate(state)
+	if len(errs) == 0 {
+		return nil
+	}
+	return slack.NewErrorsViewSubmissionResponse(errs)
+}
+
+// Input adds an input block to the modal's body and registers validators for its submission.
+// Retrieve them with SubmissionValidator.
+func (v *ViewBuilder) Input(blockID, label string, element slack.BlockElement, validators ...InputValidator) *ViewBuilder {
+	v.blocks.AddInput(blockID, label, element)
+	v.submission.Input(blockID, validators...)
+	return v
+}
+
+// SubmissionValidator returns the validators registered with Input and Form.
+func (v *ViewBuilder) SubmissionValidator() *SubmissionValidator {
+	return v.submission
+}
+
+// blockValues returns the values submitted by every element of one input block.
+func blockValues(actions map[string]slack.BlockAction) []string {
+	actionIDs := make([]string, 0, len(actions))
+	for actionID := range actions {
+		actionIDs = append(actionIDs, actionID)
+	}
+	sort.Strings(actionIDs)
+
+	var values []string
+	for _, actionID := range actionIDs {
+		values = append(values, submittedValues(actions[actionID])...)
+	}
+	return values
+}
+
+// submittedValues returns the values a user entered or selected in one element,
+// whatever its type.
+func submittedValues(action slack.BlockAction) []string {
+	values := nonEmpty(action.Value)
+	values = append(values, nonEmpty(action.SelectedOption.Value)...)
+	for _, opt := range action.SelectedOptions {
+		values = append(values, opt.Value)
+	}
+	values = append(values, nonEmpty(action.SelectedUser)...)
+	values = append(values, action.SelectedUsers...)
+	values = append(values, nonEmpty(action.SelectedConversation)...)
+	values = append(values, action.SelectedConversations...)
+	values = append(values, nonEmpty(action.SelectedChannel)...)
+	values = append(values, action.SelectedChannels...)
+	values = append(values, nonEmpty(action.SelectedDate)...)
+	values = append(values, nonEmpty(action.SelectedTime)...)
+	if action.SelectedDateTime != 0 {
+		values = append(values, strconv.FormatInt(action.SelectedDateTime, 10))
+	}
+	return values
+}
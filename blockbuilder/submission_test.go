@@ -0,0 +1,117 @@
+package blockbuilder_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestSubmissionValidatorResponse(t *testing.T) {
+	validator := blockbuilder.NewSubmissionValidator().
+		Input("ticket", blockbuilder.Required(), blockbuilder.MatchPattern(regexp.MustCompile(`^[A-Z]+-\d+$`), "Use a ticket key like OPS-12.")).
+		Input("replicas", blockbuilder.NumberRange(1, 10)).
+		Input("due", blockbuilder.FutureDate(nil, nil)).
+		Input("notes", blockbuilder.Length(0, 5))
+
+	state := &slack.ViewState{Values: map[string]map[string]slack.BlockAction{
+		"ticket":   {"ticket": {Value: "ops12"}},
+		"replicas": {"replicas": {Value: "42"}},
+		"due":      {"due": {SelectedDate: "2000-01-01"}},
+		"notes":    {"notes": {Value: "ok"}},
+	}}
+
+	resp := validator.Response(state)
+	if resp == nil {
+		t.Fatal("expected an errors response")
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var payload struct {
+		ResponseAction string            `json:"response_action"`
+		Errors         map[string]string `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if payload.ResponseAction != "errors" || len(payload.Errors) != 3 {
+		t.Fatalf("unexpected payload %s", data)
+	}
+	if payload.Errors["ticket"] != "Use a ticket key like OPS-12." {
+		t.Errorf("unexpected ticket error %q", payload.Errors["ticket"])
+	}
+
+	state.Values["ticket"]["ticket"] = slack.BlockAction{Value: "OPS-12"}
+	state.Values["replicas"]["replicas"] = slack.BlockAction{Value: "3"}
+	state.Values["due"]["due"] = slack.BlockAction{SelectedDate: "2999-01-01"}
+	if resp := validator.Response(state); resp != nil {
+		t.Errorf("expected no errors, got %+v", resp.Errors)
+	}
+}
+
+func TestFutureDate(t *testing.T) {
+	// 23:30 UTC on March 9 is already March 10 in Tokyo
+	now := func() time.Time { return time.Date(2024, 3, 9, 23, 30, 0, 0, time.UTC) }
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name  string
+		loc   *time.Location
+		value string
+		ok    bool
+	}{
+		{"tomorrow in UTC", time.UTC, "2024-03-10", true},
+		{"today in UTC", time.UTC, "2024-03-09", false},
+		{"today in Tokyo", tokyo, "2024-03-10", false},
+		{"tomorrow in Tokyo", tokyo, "2024-03-11", true},
+		{"unix seconds", time.UTC, "1900000000", false},
+		{"not a date", time.UTC, "soon", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := blockbuilder.FutureDate(now, tt.loc)([]string{tt.value})
+			if (err == nil) != tt.ok {
+				t.Errorf("FutureDate(%q) = %v, want ok %v", tt.value, err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestFutureDateTime(t *testing.T) {
+	now := func() time.Time { return time.Unix(1700000000, 0) }
+	validate := blockbuilder.FutureDateTime(now)
+
+	if err := validate([]string{"1700000060"}); err != nil {
+		t.Errorf("expected a later time to pass, got %v", err)
+	}
+	if err := validate([]string{"1700000000"}); err == nil {
+		t.Errorf("expected the current time to fail")
+	}
+	if err := validate([]string{"2024-03-10"}); err == nil {
+		t.Errorf("expected a date to fail")
+	}
+}
+
+func TestViewBuilderFormValidators(t *testing.T) {
+	type rollout struct {
+		Service  string `slack:"label=Service,required,maxlength=20"`
+		Replicas int    `slack:"label=Replicas,min=1,max=10"`
+	}
+
+	view := blockbuilder.NewViewBuilder("Roll out").Submit("Go").Form(rollout{})
+	if err := view.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+
+	errs := view.SubmissionValidator().Validate(&slack.ViewState{Values: map[string]map[string]slack.BlockAction{
+		"replicas": {"replicas": {Value: "0"}},
+	}})
+	if errs["service"] != "This field is required." || errs["replicas"] != "Must be between 1 and 10." {
+		t.Errorf("unexpected errors %v", errs)
+	}
+}
@@ -0,0 +1,46 @@
+package blockbuilder
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/mrkdwn"
+)
+
+// ellipsis marks text shortened by truncateText.
+const ellipsis = "…"
+
+// truncateText shortens text to at most limit characters, ending it with an ellipsis when cut.
+func truncateText(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	if limit < 1 {
+		return ""
+	}
+	runes := []rune(text)
+	return string(runes[:limit-1]) + ellipsis
+}
+
+// escapeTruncate escapes text for mrkdwn and shortens it to at most limit characters,
+// ending it with an ellipsis when cut and never cutting an escape sequence.
+func escapeTruncate(text string, limit int) string {
+	escaped := mrkdwn.Escape(text).String()
+	if utf8.RuneCountInString(escaped) <= limit {
+		return escaped
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range text {
+		piece := mrkdwn.Escape(string(r)).String()
+		if n+utf8.RuneCountInString(piece) > limit-1 {
+			break
+		}
+		b.WriteString(piece)
+		n += utf8.RuneCountInString(piece)
+	}
+	if limit < 1 {
+		return ""
+	}
+	return b.String() + ellipsis
+}
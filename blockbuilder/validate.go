@@ -0,0 +1,389 @@
+package blockbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+)
+
+// Block Kit limits documented by Slack.
+const (
+	MaxMessageBlocks      = 50   // Blocks per message.
+	MaxModalBlocks        = 100  // Blocks per modal or App Home view.
+	MaxSectionTextLength  = 3000 // Characters in a section's text.
+	MaxSectionFields      = 10   // Fields in a section.
+	MaxSectionFieldLength = 2000 // Characters in a single section field.
+	MaxActionElements     = 25   // Elements in an actions block.
+	MaxContextElements    = 10   // Elements in a context block.
+	MaxHeaderTextLength   = 150  // Characters in a header's text.
+	MaxBlockIDLength      = 255  // Characters in a block_id.
+	MaxActionIDLength     = 255  // Characters in an action_id.
+	MaxImageAltTextLength = 2000 // Characters in an image's alt text.
+	MaxInputLabelLength   = 2000 // Characters in an input block's label.
+
+	MaxConfirmTitleLength  = 100 // Characters in a confirmation dialog's title.
+	MaxConfirmTextLength   = 300 // Characters in a confirmation dialog's text.
+	MaxConfirmButtonLength = 30  // Characters in a confirmation dialog's confirm or deny button.
+
+	MaxViewTitleLength       = 24   // Characters in a modal's title.
+	MaxViewButtonLength      = 24   // Characters in a modal's submit or close button.
+	MaxCallbackIDLength      = 255  // Characters in a view's callback_id.
+	MaxExternalIDLength      = 255  // Characters in a view's external_id.
+	MaxPrivateMetadataLength = 3000 // Characters in a view's private_metadata.
+
+	MaxMessageTextLength = 40000 // Characters in a message's top-level text.
+)
+
+// ValidationError describes a single Block Kit limit violation.
+type ValidationError struct {
+	BlockIndex int    // Index of the offending block, or -1 for problems with the whole message.
+	BlockID    string // block_id of the offending block, if set.
+	Message    string // Description of the violation.
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	switch {
+	case e.BlockIndex < 0:
+		return e.Message
+	case e.BlockID != "":
+		return fmt.Sprintf("block %d (%s): %s", e.BlockIndex, e.BlockID, e.Message)
+	default:
+		return fmt.Sprintf("block %d: %s", e.BlockIndex, e.Message)
+	}
+}
+
+// ValidationErrors collects every violation found while validating a set of blocks.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface, listing every violation on its own line.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Validate checks the assembled blocks against Slack's limits for messages.
+// It returns nil or a ValidationErrors listing every violation.
+func (b *BlockBuilder) Validate() error {
+	return ValidateBlocks(b.blocks, MaxMessageBlocks)
+}
+
+// Validate checks the attachment's blocks against Slack's limits for messages and its legacy
+// fields for combinations Slack ignores. It returns nil or a ValidationErrors listing every
+// violation.
+func (a *AttachmentBuilder) Validate() error {
+	if errs := validateAttachment(a.attachment); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateAttachment checks an attachment's blocks and legacy fields.
+func validateAttachment(attachment slack.Attachment) ValidationErrors {
+	v := &validator{blockIDs: make(map[string]int)}
+
+	v.validateBlocks(attachment.Blocks.BlockSet, MaxMessageBlocks)
+	v.validateLegacyAttachment(attachment)
+
+	return v.errs
+}
+
+// ValidateBlocks checks blocks against Slack's documented Block Kit limits, allowing at most
+// maxBlocks blocks (MaxMessageBlocks for messages, MaxModalBlocks for modals and App Home).
+// It returns nil or a ValidationErrors listing every violation with the index of its block.
+func ValidateBlocks(blocks []slack.Block, maxBlocks int) error {
+	v := &validator{blockIDs: make(map[string]int)}
+
+	v.validateBlocks(blocks, maxBlocks)
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// validator accumulates violations across blocks.
+type validator struct {
+	errs     ValidationErrors
+	blockIDs map[string]int
+}
+
+// validateBlocks checks the number of blocks against maxBlocks and validates each block.
+func (v *validator) validateBlocks(blocks []slack.Block, maxBlocks int) {
+	if len(blocks) > maxBlocks {
+		v.addf(-1, "", "too many blocks: %d (max %d)", len(blocks), maxBlocks)
+	}
+	for i, block := range blocks {
+		v.validateBlock(i, block)
+	}
+}
+
+// addf records a violation for the block at index.
+func (v *validator) addf(index int, blockID, format string, args ...any) {
+	v.errs = append(v.errs, ValidationError{
+		BlockIndex: index,
+		BlockID:    blockID,
+		Message:    fmt.Sprintf(format, args...),
+	})
+}
+
+// validateBlock checks a single block and the elements it contains.
+func (v *validator) validateBlock(i int, block slack.Block) {
+	if block == nil {
+		v.addf(i, "", "block is nil")
+		return
+	}
+
+	id := blockID(block)
+	if n := utf8.RuneCountInString(id); n > MaxBlockIDLength {
+		v.addf(i, id, "block_id is %d characters (max %d)", n, MaxBlockIDLength)
+	}
+	if id != "" {
+		if first, ok := v.blockIDs[id]; ok {
+			v.addf(i, id, "block_id is already used by block %d", first)
+		} else {
+			v.blockIDs[id] = i
+		}
+	}
+
+	switch b := block.(type) {
+	case *slack.SectionBlock:
+		v.validateSection(i, id, b)
+	case *AccessorySection:
+		v.validateSection(i, id, b.SectionBlock)
+		if b.Accessory != nil && newAccessory(b.Accessory) == nil {
+			v.addf(i, id, "section accessory %T is not supported", b.Accessory)
+		}
+	case *slack.ActionBlock:
+		var elements []slack.BlockElement
+		if b.Elements != nil {
+			elements = b.Elements.ElementSet
+		}
+		if len(elements) == 0 {
+			v.addf(i, id, "actions block has no elements")
+		}
+		if len(elements) > MaxActionElements {
+			v.addf(i, id, "actions block has %d elements (max %d)", len(elements), MaxActionElements)
+		}
+		v.validateElements(i, id, elements...)
+	case *slack.ContextBlock:
+		elements := b.ContextElements.Elements
+		if len(elements) == 0 {
+			v.addf(i, id, "context block has no elements")
+		}
+		if len(elements) > MaxContextElements {
+			v.addf(i, id, "context block has %d elements (max %d)", len(elements), MaxContextElements)
+		}
+		for _, el := range elements {
+			if img, ok := el.(*slack.ImageBlockElement); ok {
+				v.validateAltText(i, id, "context image", img.AltText)
+			}
+		}
+	case *slack.ImageBlock:
+		if b.ImageURL == "" && b.SlackFile == nil {
+			v.addf(i, id, "image block requires image_url or slack_file")
+		}
+		v.validateAltText(i, id, "image block", b.AltText)
+	case *slack.HeaderBlock:
+		if b.Text == nil || b.Text.Text == "" {
+			v.addf(i, id, "header block requires text")
+		} else if n := utf8.RuneCountInString(b.Text.Text); n > MaxHeaderTextLength {
+			v.addf(i, id, "header text is %d characters (max %d)", n, MaxHeaderTextLength)
+		}
+	case *slack.InputBlock:
+		if b.Label == nil || b.Label.Text == "" {
+			v.addf(i, id, "input block requires a label")
+		} else if n := utf8.RuneCountInString(b.Label.Text); n > MaxInputLabelLength {
+			v.addf(i, id, "input label is %d characters (max %d)", n, MaxInputLabelLength)
+		}
+		if b.Element == nil {
+			v.addf(i, id, "input block requires an element")
+		} else {
+			v.validateElements(i, id, b.Element)
+		}
+	case *slack.VideoBlock:
+		v.validateAltText(i, id, "video block", b.AltText)
+		if b.Title == nil || b.Title.Text == "" {
+			v.addf(i, id, "video block requires a title")
+		}
+	}
+}
+
+// validateSection checks a section's text, fields and accessory.
+func (v *validator) validateSection(i int, id string, b *slack.SectionBlock) {
+	if (b.Text == nil || b.Text.Text == "") && len(b.Fields) == 0 {
+		v.addf(i, id, "section block requires text or fields")
+	}
+	if b.Text != nil {
+		if n := utf8.RuneCountInString(b.Text.Text); n > MaxSectionTextLength {
+			v.addf(i, id, "section text is %d characters (max %d)", n, MaxSectionTextLength)
+		}
+	}
+	if len(b.Fields) > MaxSectionFields {
+		v.addf(i, id, "section has %d fields (max %d)", len(b.Fields), MaxSectionFields)
+	}
+	for j, field := range b.Fields {
+		if field == nil {
+			continue
+		}
+		if n := utf8.RuneCountInString(field.Text); n > MaxSectionFieldLength {
+			v.addf(i, id, "section field %d is %d characters (max %d)", j, n, MaxSectionFieldLength)
+		}
+	}
+	if b.Accessory != nil {
+		if el := accessoryElement(b.Accessory); el != nil {
+			v.validateElements(i, id, el)
+		}
+	}
+}
+
+// validateElements checks interactive elements within one block: action_id length and
+// uniqueness (Slack requires action_ids to be unique within their block), confirmation
+// dialogs and image alt text.
+func (v *validator) validateElements(i int, id string, elements ...slack.BlockElement) {
+	actionIDs := make(map[string]bool)
+	for _, el := range elements {
+		if img, ok := el.(*slack.ImageBlockElement); ok {
+			v.validateAltText(i, id, "image element", img.AltText)
+			continue
+		}
+		if confirm := elementConfirm(el); confirm != nil {
+			v.validateConfirm(i, id, confirm)
+		}
+		actionID := elementActionID(el)
+		if actionID == "" {
+			continue
+		}
+		if n := utf8.RuneCountInString(actionID); n > MaxActionIDLength {
+			v.addf(i, id, "action_id %q is %d characters (max %d)", actionID, n, MaxActionIDLength)
+		}
+		if actionIDs[actionID] {
+			v.addf(i, id, "action_id %q is used more than once", actionID)
+		}
+		actionIDs[actionID] = true
+	}
+}
+
+// validateAltText checks that required alt text is present and within limits.
+func (v *validator) validateAltText(i int, id, what, altText string) {
+	if strings.TrimSpace(altText) == "" {
+		v.addf(i, id, "%s requires alt text", what)
+	} else if n := utf8.RuneCountInString(altText); n > MaxImageAltTextLength {
+		v.addf(i, id, "%s alt text is %d characters (max %d)", what, n, MaxImageAltTextLength)
+	}
+}
+
+// blockID returns the block_id of any block, including types not known to this package.
+func blockID(block slack.Block) string {
+	switch b := block.(type) {
+	case *slack.SectionBlock:
+		return b.BlockID
+	case *AccessorySection:
+		return b.BlockID
+	case *slack.ActionBlock:
+		return b.BlockID
+	case *slack.ContextBlock:
+		return b.BlockID
+	case *slack.ImageBlock:
+		return b.BlockID
+	case *slack.DividerBlock:
+		return b.BlockID
+	case *slack.HeaderBlock:
+		return b.BlockID
+	case *slack.InputBlock:
+		return b.BlockID
+	case *slack.RichTextBlock:
+		return b.BlockID
+	case *slack.VideoBlock:
+		return b.BlockID
+	case *slack.FileBlock:
+		return b.BlockID
+	default:
+		return jsonStringField(block, "block_id")
+	}
+}
+
+// elementActionID returns the action_id of any block element.
+func elementActionID(el slack.BlockElement) string {
+	switch e := el.(type) {
+	case *slack.ButtonBlockElement:
+		return e.ActionID
+	case *AccessibleButton:
+		return e.ActionID
+	case *slack.SelectBlockElement:
+		return e.ActionID
+	case *slack.MultiSelectBlockElement:
+		return e.ActionID
+	case *slack.OverflowBlockElement:
+		return e.ActionID
+	case *slack.DatePickerBlockElement:
+		return e.ActionID
+	case *slack.TimePickerBlockElement:
+		return e.ActionID
+	case *slack.DateTimePickerBlockElement:
+		return e.ActionID
+	case *slack.PlainTextInputBlockElement:
+		return e.ActionID
+	case *slack.CheckboxGroupsBlockElement:
+		return e.ActionID
+	case *slack.RadioButtonsBlockElement:
+		return e.ActionID
+	default:
+		return jsonStringField(el, "action_id")
+	}
+}
+
+// accessoryElement returns the element held by a section accessory.
+func accessoryElement(a *slack.Accessory) slack.BlockElement {
+	switch {
+	case a.ImageElement != nil:
+		return a.ImageElement
+	case a.ButtonElement != nil:
+		return a.ButtonElement
+	case a.OverflowElement != nil:
+		return a.OverflowElement
+	case a.DatePickerElement != nil:
+		return a.DatePickerElement
+	case a.TimePickerElement != nil:
+		return a.TimePickerElement
+	case a.PlainTextInputElement != nil:
+		return a.PlainTextInputElement
+	case a.RichTextInputElement != nil:
+		return a.RichTextInputElement
+	case a.RadioButtonsElement != nil:
+		return a.RadioButtonsElement
+	case a.SelectElement != nil:
+		return a.SelectElement
+	case a.MultiSelectElement != nil:
+		return a.MultiSelectElement
+	case a.CheckboxGroupsBlockElement != nil:
+		return a.CheckboxGroupsBlockElement
+	case a.UnknownElement != nil:
+		return a.UnknownElement
+	default:
+		return nil
+	}
+}
+
+// jsonStringField marshals v and returns the named top-level string field, if any.
+func jsonStringField(v any, key string) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(fields[key], &s); err != nil {
+		return ""
+	}
+	return s
+}
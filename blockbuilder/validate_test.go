@@ -0,0 +1,67 @@
+package blockbuilder_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestValidateValidMessage(t *testing.T) {
+	builder := blockbuilder.NewBlockBuilder().
+		AddSection("Hello", true).
+		AddDivider().
+		AddImage("https://example.com/a.png", "An example image").
+		AddActions(
+			blockbuilder.NewButton("approve", "Approve", "1"),
+			blockbuilder.NewButton("reject", "Reject", "0"),
+		)
+
+	if err := builder.Validate(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateReportsEveryViolation(t *testing.T) {
+	builder := blockbuilder.NewBlockBuilder()
+	for i := 0; i < 51; i++ {
+		builder.AddDivider()
+	}
+	builder.AddSection(strings.Repeat("x", 3001), false)
+	builder.AddImage("https://example.com/a.png", "")
+	builder.AddActions(
+		blockbuilder.NewButton("dup", "One", "1"),
+		blockbuilder.NewButton("dup", "Two", "2"),
+	)
+
+	err := builder.Validate()
+	var verrs blockbuilder.ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %v", err)
+	}
+
+	wantIndexes := []int{-1, 51, 52, 53}
+	if len(verrs) != len(wantIndexes) {
+		t.Fatalf("expected %d violations, got %d: %v", len(wantIndexes), len(verrs), err)
+	}
+	for i, want := range wantIndexes {
+		if verrs[i].BlockIndex != want {
+			t.Errorf("violation %d: expected block index %d, got %d (%s)", i, want, verrs[i].BlockIndex, verrs[i].Message)
+		}
+	}
+}
+
+func TestValidateBlocksDuplicateBlockID(t *testing.T) {
+	blocks := []slack.Block{
+		slack.NewDividerBlock(),
+		slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", "a", false, false), nil, nil, slack.SectionBlockOptionBlockID("same")),
+		slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", "b", false, false), nil, nil, slack.SectionBlockOptionBlockID("same")),
+	}
+
+	err := blockbuilder.ValidateBlocks(blocks, blockbuilder.MaxModalBlocks)
+	if err == nil || !strings.Contains(err.Error(), "block 2 (same)") {
+		t.Errorf("expected duplicate block_id error on block 2, got %v", err)
+	}
+}
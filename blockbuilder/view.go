@@ -0,0 +1,152 @@
+package blockbuilder
+
+import (
+	"unicode/utf8"
+
+	"github.com/slack-go/slack"
+)
+
+// ViewBuilder builds a modal view for views.open, views.push and views.update.
+type ViewBuilder struct {
+	view        slack.ModalViewRequest
+	blocks      *BlockBuilder
+	metadataErr error
+	formErr     error
+	submission  *SubmissionValidator
+}
+
+// NewViewBuilder initializes a ViewBuilder for a modal with a plain text title.
+func NewViewBuilder(title string) *ViewBuilder {
+	return &ViewBuilder{
+		view: slack.ModalViewRequest{
+			Type:  slack.VTModal,
+			Title: slack.NewTextBlockObject("plain_text", title, true, false),
+		},
+		blocks:     NewBlockBuilder(),
+		submission: NewSubmissionValidator(),
+	}
+}
+
+// Submit sets the text of the submit button. Modals containing input blocks require one.
+func (v *ViewBuilder) Submit(text string) *ViewBuilder {
+	v.view.Submit = slack.NewTextBlockObject("plain_text", text, true, false)
+	return v
+}
+
+// Close sets the text of the close button.
+func (v *ViewBuilder) Close(text string) *ViewBuilder {
+	v.view.Close = slack.NewTextBlockObject("plain_text", text, true, false)
+	return v
+}
+
+// CallbackID sets the identifier sent with the modal's submission and close payloads.
+func (v *ViewBuilder) CallbackID(callbackID string) *ViewBuilder {
+	v.view.CallbackID = callbackID
+	return v
+}
+
+// ExternalID sets a workspace-unique identifier for updating the modal later.
+func (v *ViewBuilder) ExternalID(externalID string) *ViewBuilder {
+	v.view.ExternalID = externalID
+	return v
+}
+
+// NotifyOnClose asks Slack to send a view_closed event when the user closes the modal.
+func (v *ViewBuilder) NotifyOnClose() *ViewBuilder {
+	v.view.NotifyOnClose = true
+	return v
+}
+
+// ClearOnClose closes every view in the stack when the user closes the modal.
+func (v *ViewBuilder) ClearOnClose() *ViewBuilder {
+	v.view.ClearOnClose = true
+	return v
+}
+
+// Blocks sets the body of the modal, replacing any blocks added before.
+func (v *ViewBuilder) Blocks(builder *BlockBuilder) *ViewBuilder {
+	v.blocks = builder
+	return v
+}
+
+// Body returns the BlockBuilder holding the body of the modal, for adding blocks in place.
+func (v *ViewBuilder) Body() *BlockBuilder {
+	return v.blocks
+}
+
+// PrivateMetadata encodes data as JSON into the modal's private_metadata.
+// Encoding errors, including exceeding MaxPrivateMetadataLength, are reported by Validate.
+// Read the data back with DecodePrivateMetadata.
+func (v *ViewBuilder) PrivateMetadata(data any) *ViewBuilder {
+	v.view.PrivateMetadata, v.metadataErr = EncodePrivateMetadata(data)
+	return v
+}
+
+// Validate checks the modal and its blocks against Slack's limits.
+// It returns nil or a ValidationErrors listing every violation.
+func (v *ViewBuilder) Validate() error {
+	vv := &validator{blockIDs: make(map[string]int)}
+
+	vv.validateViewText("title", v.view.Title, MaxViewTitleLength, true)
+	vv.validateViewText("submit", v.view.Submit, MaxViewButtonLength, false)
+	vv.validateViewText("close", v.view.Close, MaxViewButtonLength, false)
+	if v.view.Submit == nil && hasInputBlock(v.blocks.Build()) {
+		vv.addf(-1, "", "modal with input blocks requires submit text")
+	}
+	vv.validateViewIDs(v.view.CallbackID, v.view.ExternalID)
+	if v.metadataErr != nil {
+		vv.addf(-1, "", "%s", v.metadataErr)
+	}
+	if v.formErr != nil {
+		vv.addf(-1, "", "%s", v.formErr)
+	}
+	vv.validateBlocks(v.blocks.Build(), MaxModalBlocks)
+
+	if len(vv.errs) == 0 {
+		return nil
+	}
+	return vv.errs
+}
+
+// Build returns the modal view request.
+func (v *ViewBuilder) Build() slack.ModalViewRequest {
+	view := v.view
+	view.Blocks = slack.Blocks{BlockSet: v.blocks.Build()}
+	return view
+}
+
+// validateViewText checks a view's title or button text.
+func (v *validator) validateViewText(name string, text *slack.TextBlockObject, limit int, required bool) {
+	switch {
+	case text == nil || text.Text == "":
+		if required || text != nil {
+			v.addf(-1, "", "view %s is required", name)
+		}
+	case text.Type != "plain_text":
+		v.addf(-1, "", "view %s must be plain_text", name)
+	default:
+		if n := utf8.RuneCountInString(text.Text); n > limit {
+			v.addf(-1, "", "view %s is %d characters (max %d)", name, n, limit)
+		}
+	}
+}
+
+// validateViewIDs checks a view's callback_id and external_id lengths.
+func (v *validator) validateViewIDs(callbackID, externalID string) {
+	if n := utf8.RuneCountInString(callbackID); n > MaxCallbackIDLength {
+		v.addf(-1, "", "callback_id is %d characters (max %d)", n, MaxCallbackIDLength)
+	}
+	if n := utf8.RuneCountInString(externalID); n > MaxExternalIDLength {
+		v.addf(-1, "", "external_id is %d characters (max %d)", n, MaxExternalIDLength)
+	}
+}
+
+// hasInputBlock reports whether blocks contains an input block.
+func hasInputBlock(blocks []slack.Block) bool {
+	for _, block := range blocks {
+		if _, ok := block.(*slack.InputBlock); ok {
+			return true
+		}
+	}
+	return false
+}
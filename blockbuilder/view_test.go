@@ -0,0 +1,54 @@
+package blockbuilder_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+type incidentMetadata struct {
+	IncidentID string `json:"incident_id"`
+	ChannelID  string `json:"channel_id"`
+}
+
+func TestViewBuilder(t *testing.T) {
+	view := blockbuilder.NewViewBuilder("Declare incident").
+		Submit("Declare").
+		Close("Cancel").
+		CallbackID("declare_incident").
+		NotifyOnClose().
+		PrivateMetadata(incidentMetadata{IncidentID: "INC-7", ChannelID: "C123"})
+	view.Body().AddInput("summary", "Summary", blockbuilder.NewPlainTextInput("summary").Build())
+
+	if err := view.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+
+	req := view.Build()
+	if req.Type != slack.VTModal || req.CallbackID != "declare_incident" || !req.NotifyOnClose {
+		t.Errorf("unexpected view: %+v", req)
+	}
+	if len(req.Blocks.BlockSet) != 1 {
+		t.Fatalf("expected 1 block, got %d", len(req.Blocks.BlockSet))
+	}
+
+	meta, err := blockbuilder.DecodePrivateMetadata[incidentMetadata](req.PrivateMetadata)
+	if err != nil || meta.IncidentID != "INC-7" || meta.ChannelID != "C123" {
+		t.Errorf("unexpected metadata %+v (err %v)", meta, err)
+	}
+}
+
+func TestViewBuilderValidation(t *testing.T) {
+	view := blockbuilder.NewViewBuilder("A title that is far too long for a modal").
+		Close("Cancel").
+		PrivateMetadata(strings.Repeat("x", blockbuilder.MaxPrivateMetadataLength))
+	view.Body().AddInput("name", "Name", blockbuilder.NewPlainTextInput("name").Build())
+
+	var verrs blockbuilder.ValidationErrors
+	if err := view.Validate(); !errors.As(err, &verrs) || len(verrs) != 3 {
+		t.Fatalf("expected title, submit and metadata violations, got %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+package blockbuilder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ResponseType sets the response_type of a reply sent to a response_url:
+// slack.ResponseTypeInChannel or slack.ResponseTypeEphemeral.
+func (m *MessageBuilder) ResponseType(responseType string) *MessageBuilder {
+	m.responseType = responseType
+	return m
+}
+
+// ReplaceOriginal makes a reply sent to a response_url replace the message that triggered
+// the interaction.
+func (m *MessageBuilder) ReplaceOriginal() *MessageBuilder {
+	m.replaceOriginal = true
+	return m
+}
+
+// DeleteOriginal makes a reply sent to a response_url delete the message that triggered
+// the interaction.
+func (m *MessageBuilder) DeleteOriginal() *MessageBuilder {
+	m.deleteOriginal = true
+	return m
+}
+
+// WebhookMessage returns the message for an incoming webhook or a response_url.
+// Metadata, ephemeral targets and disabled unfurling have no webhook equivalent and are
+// dropped; use JSON to keep the unfurl settings.
+func (m *MessageBuilder) WebhookMessage() *slack.WebhookMessage {
+	msg := &slack.WebhookMessage{
+		Username:        m.username,
+		IconEmoji:       m.iconEmoji,
+		IconURL:         m.iconURL,
+		Channel:         m.channel,
+		ThreadTimestamp: m.threadTS,
+		Text:            m.fallbackText(),
+		Attachments:     m.attachments,
+		ResponseType:    m.responseType,
+		ReplaceOriginal: m.replaceOriginal,
+		DeleteOriginal:  m.deleteOriginal,
+		ReplyBroadcast:  m.broadcast,
+		UnfurlLinks:     m.unfurlLinks != nil && *m.unfurlLinks,
+		UnfurlMedia:     m.unfurlMedia != nil && *m.unfurlMedia,
+	}
+	if len(m.blocks) > 0 {
+		msg.Blocks = &slack.Blocks{BlockSet: m.blocks}
+	}
+	return msg
+}
+
+// WebhookMessage returns the blocks as an incoming webhook message with fallback text.
+func (b *BlockBuilder) WebhookMessage() *slack.WebhookMessage {
+	return NewMessageBuilder().BlocksFrom(b).WebhookMessage()
+}
+
+// WebhookError reports a webhook request that Slack rejected.
+type WebhookError struct {
+	StatusCode int    // HTTP status code of the last attempt.
+	Body       string // Response body, such as "invalid_blocks".
+}
+
+// Error implements the error interface.
+func (e *WebhookError) Error() string {
+	return fmt.Sprintf("webhook returned %d: %s", e.StatusCode, e.Body)
+}
+
+// WebhookSender posts messages to incoming webhook and response_url URLs, retrying when
+// Slack responds with 429 or a 5xx status or the request fails.
+type WebhookSender struct {
+	client     *http.Client
+	maxRetries int
+	backoff    time.Duration
+}
+
+// WebhookSenderOption configures a WebhookSender.
+type WebhookSenderOption func(*WebhookSender)
+
+// WebhookSenderOptionHTTPClient sets the HTTP client used for requests.
+func WebhookSenderOptionHTTPClient(client *http.Client) WebhookSenderOption {
+	return func(s *WebhookSender) {
+		s.client = client
+	}
+}
+
+// WebhookSenderOptionMaxRetries sets how often a failed request is retried. The default is 3.
+func WebhookSenderOptionMaxRetries(n int) WebhookSenderOption {
+	return func(s *WebhookSender) {
+		s.maxRetries = n
+	}
+}
+
+// WebhookSenderOptionBackoff sets the wait before the first retry, doubled for every further
+// retry. The default is one second. A Retry-After header from Slack takes precedence.
+func WebhookSenderOptionBackoff(backoff time.Duration) WebhookSenderOption {
+	return func(s *WebhookSender) {
+		s.backoff = backoff
+	}
+}
+
+// NewWebhookSender initializes a WebhookSender.
+func NewWebhookSender(opts ...WebhookSenderOption) *WebhookSender {
+	s := &WebhookSender{
+		client:     http.DefaultClient,
+		maxRetries: 3,
+		backoff:    time.Second,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Send posts msg to url. It returns a *WebhookError if Slack rejects the message or keeps
+// failing after every retry.
+func (s *WebhookSender) Send(ctx context.Context, url string, msg *slack.WebhookMessage) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	wait := s.backoff
+	for attempt := 0; ; attempt++ {
+		retryAfter, err := s.post(ctx, url, body)
+		if err == nil || attempt >= s.maxRetries || !retryable(err) {
+			return err
+		}
+		if retryAfter <= 0 {
+			retryAfter = wait
+			wait *= 2
+		}
+		timer := time.NewTimer(retryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// post makes one request and returns the wait requested by a Retry-After header.
+func (s *WebhookSender) post(ctx context.Context, url string, body []byte) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		// Drain a small body so the connection can be reused; larger bodies are not worth reading
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+		resp.Body.Close()
+	}()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode == http.StatusOK {
+		return 0, nil
+	}
+	return parseRetryAfter(resp.Header.Get("Retry-After")), &WebhookError{StatusCode: resp.StatusCode, Body: string(respBody)}
+}
+
+// parseRetryAfter returns the wait requested by a Retry-After header given in seconds or as
+// an HTTP date, or 0 if there is none.
+func parseRetryAfter(header string) time.Duration {
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+// retryable reports whether a request that failed with err may succeed when repeated: Slack
+// answered with 429 or a server error, or the connection could not be established for a
+// reason that may pass. Errors building the request and context errors are final.
+func retryable(err error) bool {
+	var webhookErr *WebhookError
+	if errors.As(err, &webhookErr) {
+		return webhookErr.StatusCode == http.StatusTooManyRequests || webhookErr.StatusCode >= 500
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
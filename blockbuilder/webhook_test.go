@@ -0,0 +1,153 @@
+package blockbuilder_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/blockbuilder"
+	"github.com/slack-go/slack"
+)
+
+func TestMessageBuilderWebhookMessage(t *testing.T) {
+	msg := blockbuilder.NewMessageBuilder().
+		BlocksFrom(blockbuilder.NewBlockBuilder().AddSection("Approved", false)).
+		ResponseType(slack.ResponseTypeInChannel).
+		ReplaceOriginal().
+		WebhookMessage()
+
+	if msg.Text != "Approved" || msg.ResponseType != "in_channel" || !msg.ReplaceOriginal || msg.DeleteOriginal {
+		t.Errorf("WebhookMessage = %+v", msg)
+	}
+	if msg.Blocks == nil || len(msg.Blocks.BlockSet) != 1 {
+		t.Errorf("Blocks = %+v", msg.Blocks)
+	}
+
+	data, err := blockbuilder.NewMessageBuilder().DeleteOriginal().JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"delete_original":true}` {
+		t.Errorf("JSON = %s", data)
+	}
+}
+
+func TestWebhookSenderRetries(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg slack.WebhookMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil || msg.Text != "hello" {
+			t.Errorf("request body: %+v, %v", msg, err)
+		}
+		switch calls.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Write([]byte("ok"))
+		}
+	}))
+	defer server.Close()
+
+	sender := blockbuilder.NewWebhookSender(
+		blockbuilder.WebhookSenderOptionHTTPClient(server.Client()),
+		blockbuilder.WebhookSenderOptionBackoff(time.Millisecond),
+	)
+	if err := sender.Send(context.Background(), server.URL, &slack.WebhookMessage{Text: "hello"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3", calls.Load())
+	}
+}
+
+func TestWebhookSenderErrors(t *testing.T) {
+	var calls atomic.Int32
+	status := http.StatusBadRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(status)
+		w.Write([]byte("invalid_blocks"))
+	}))
+	defer server.Close()
+
+	sender := blockbuilder.NewWebhookSender(
+		blockbuilder.WebhookSenderOptionMaxRetries(2),
+		blockbuilder.WebhookSenderOptionBackoff(time.Millisecond),
+	)
+
+	err := sender.Send(context.Background(), server.URL, &slack.WebhookMessage{Text: "x"})
+	var webhookErr *blockbuilder.WebhookError
+	if !errors.As(err, &webhookErr) || webhookErr.StatusCode != http.StatusBadRequest || webhookErr.Body != "invalid_blocks" {
+		t.Fatalf("Send = %v, want invalid_blocks error", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("client error retried: calls = %d", calls.Load())
+	}
+
+	calls.Store(0)
+	status = http.StatusServiceUnavailable
+	err = sender.Send(context.Background(), server.URL, &slack.WebhookMessage{Text: "x"})
+	if !errors.As(err, &webhookErr) || webhookErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Send = %v, want 503 error", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3", calls.Load())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sender.Send(ctx, server.URL, &slack.WebhookMessage{Text: "x"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Send with canceled context = %v", err)
+	}
+}
+
+func TestWebhookSenderRetryAfterDate(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", time.Now().Add(time.Second).UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	// The backoff would outlast the deadline, so only the header's date can lead to a retry
+	sender := blockbuilder.NewWebhookSender(blockbuilder.WebhookSenderOptionBackoff(time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := sender.Send(ctx, server.URL, &slack.WebhookMessage{Text: "x"}); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("calls = %d, want 2", calls.Load())
+	}
+}
+
+func TestWebhookSenderFinalErrors(t *testing.T) {
+	sender := blockbuilder.NewWebhookSender(blockbuilder.WebhookSenderOptionBackoff(time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := sender.Send(ctx, "://missing-scheme", &slack.WebhookMessage{Text: "x"})
+	if err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Send with an invalid URL = %v, want an immediate error", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+	}))
+	defer server.Close()
+	short, cancelShort := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancelShort()
+	if err := sender.Send(short, server.URL, &slack.WebhookMessage{Text: "x"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Send past its deadline = %v", err)
+	}
+}
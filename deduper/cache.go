@@ -3,64 +3,111 @@
 package deduper
 
 import (
-	"sync"
 	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/set"
 )
 
 // Cache stores event IDs with safe concurrent access.
 type Cache struct {
-	items       map[string]time.Time
-	mu          sync.RWMutex
+	set         *set.Set[string]
 	evictPolicy *EvictionPolicy
+	clock       Clock
+	onEvict     func(eventID string, entry Entry, reason EvictReason)
+}
+
+// CacheOption defines a functional option for Cache.
+type CacheOption func(*Cache)
+
+// CacheOptionClock sets the clock used to timestamp added items.
+func CacheOptionClock(clock Clock) CacheOption {
+	return func(c *Cache) {
+		c.clock = clock
+	}
+}
+
+// CacheOptionOnEvict registers a handler called for every event ID removed by eviction.
+// The handler runs while the cache is locked and must not call back into the cache.
+func CacheOptionOnEvict(handler func(eventID string, entry Entry, reason EvictReason)) CacheOption {
+	return func(c *Cache) {
+		c.onEvict = handler
+	}
 }
 
 // NewCache initializes a new cache.
-func NewCache(evictPolicy *EvictionPolicy) *Cache {
-	return &Cache{
-		items:       make(map[string]time.Time),
+func NewCache(evictPolicy *EvictionPolicy, opts ...CacheOption) *Cache {
+	c := &Cache{
 		evictPolicy: evictPolicy,
+		clock:       set.RealClock(),
 	}
+
+	// Apply functional options
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.set = set.New[string](evictPolicy.setPolicy(), set.OptionClock(c.clock))
+	if c.onEvict != nil {
+		c.set.OnEvict(func(eventID string, entry set.Entry, reason EvictReason) {
+			c.onEvict(eventID, entryFromSet(entry), reason)
+		})
+	}
+	return c
 }
 
 // Has checks if the eventID is already in the cache.
 func (c *Cache) Has(eventID string) bool {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	_, exists := c.items[eventID]
-	return exists
+	return c.set.Has(eventID)
 }
 
 // Add inserts a new eventID into the cache and applies eviction if needed.
+// Adding an eventID that is already cached records another sighting of it.
 func (c *Cache) Add(eventID string) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.AddWithMetadata(eventID, EntryMetadata{})
+}
+
+// AddWithTTL inserts a new eventID that expires after ttl instead of the policy's time limit.
+func (c *Cache) AddWithTTL(eventID string, ttl time.Duration) {
+	c.AddWithMetadata(eventID, EntryMetadata{TTL: ttl})
+}
 
-	c.items[eventID] = time.Now()
-	c.evictPolicy.Apply(c)
+// AddWithMetadata inserts a new eventID with metadata and applies eviction if needed.
+// If the eventID is already cached, its last-seen time and count are updated and meta is ignored.
+func (c *Cache) AddWithMetadata(eventID string, meta EntryMetadata) {
+	c.add(eventID, meta)
+}
+
+// add records eventID and returns its entry and whether it was new.
+func (c *Cache) add(eventID string, meta EntryMetadata) (Entry, bool) {
+	entry, isNew := c.set.AddWithMetadata(eventID, meta.setMetadata())
+	return entryFromSet(entry), isNew
+}
+
+// Entry returns the cached entry for eventID, if present.
+func (c *Cache) Entry(eventID string) (Entry, bool) {
+	entry, ok := c.set.Entry(eventID)
+	if !ok {
+		return Entry{}, false
+	}
+	return entryFromSet(entry), true
 }
 
 // Size returns the current size of the cache.
 func (c *Cache) Size() int {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	return len(c.items)
+	return c.set.Len()
 }
 
 // Evict removes an eventID from the cache.
 func (c *Cache) Evict(eventID string) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	delete(c.items, eventID)
+	c.set.Remove(eventID)
 }
 
 // Items returns a copy of the current items in the cache.
-func (c *Cache) Items() map[string]time.Time {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	copiedItems := make(map[string]time.Time, len(c.items))
-	for k, v := range c.items {
-		copiedItems[k] = v
+func (c *Cache) Items() map[string]Entry {
+	items := c.set.Items()
+	copiedItems := make(map[string]Entry, len(items))
+	for eventID, entry := range items {
+		copiedItems[eventID] = entryFromSet(entry)
 	}
 	return copiedItems
 }
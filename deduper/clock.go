@@ -0,0 +1,13 @@
+// deduper/clock.go
+
+package deduper
+
+import (
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/set"
+)
+
+// Clock provides the current time and tickers so tests can control the passage of time.
+type Clock = set.Clock
+
+// Ticker delivers ticks at intervals, mirroring time.Ticker.
+type Ticker = set.Ticker
@@ -3,9 +3,11 @@
 package deduper
 
 import (
+	"log/slog"
 	"sync"
 	"time"
 
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/set"
 	"github.com/slack-go/slack/socketmode"
 )
 
@@ -18,8 +20,15 @@ type Dedupe struct {
 	evictInterval time.Duration
 	stopAutoEvict chan struct{}
 	wg            sync.WaitGroup
+	clock         Clock
+	onDuplicate   DuplicateHandler
+	logger        *slog.Logger
 }
 
+// DuplicateHandler is called by Middleware when it drops a duplicate event.
+// firstSeen and count describe the cached entry, with count including this duplicate.
+type DuplicateHandler func(evt *socketmode.Event, firstSeen time.Time, count int)
+
 // Option defines a functional option for Dedupe.
 type Option func(*Dedupe)
 
@@ -32,12 +41,35 @@ func OptionAutoEvict(interval time.Duration) Option {
 	}
 }
 
+// OptionClock sets the clock used for timestamps and the automatic eviction ticker.
+// With NewDedupeWithEvictPolicy, pass the same clock to the policy via EvictionPolicyOptionClock.
+func OptionClock(clock Clock) Option {
+	return func(d *Dedupe) {
+		d.clock = clock
+	}
+}
+
+// OptionOnDuplicate registers a handler that Middleware calls for every duplicate it drops.
+// Use ExtractRetryInfoFromSocketMode inside the handler to see why Slack retried the event.
+func OptionOnDuplicate(handler DuplicateHandler) Option {
+	return func(d *Dedupe) {
+		d.onDuplicate = handler
+	}
+}
+
+// OptionLogger sets a structured logger for deduplication decisions, eviction and event ID extraction.
+// Records carry event_id, envelope_id, team_id, event_type and reason attributes.
+// Without a logger, Middleware logs through the socketmode client's Debugf.
+func OptionLogger(logger *slog.Logger) Option {
+	return func(d *Dedupe) {
+		d.logger = logger
+	}
+}
+
 // NewDedupe initializes a new deduplication handler.
 func NewDedupe(sizeLimit int, timeLimit time.Duration, countLimit int, opts ...Option) *Dedupe {
-	evictPolicy := NewEvictionPolicy(sizeLimit, timeLimit, countLimit)
 	d := &Dedupe{
-		cache:       NewCache(evictPolicy),
-		evictPolicy: evictPolicy,
+		clock: set.RealClock(),
 	}
 
 	// Apply functional options
@@ -45,6 +77,9 @@ func NewDedupe(sizeLimit int, timeLimit time.Duration, countLimit int, opts ...O
 		opt(d)
 	}
 
+	d.evictPolicy = NewEvictionPolicy(sizeLimit, timeLimit, countLimit, EvictionPolicyOptionClock(d.clock))
+	d.cache = NewCache(d.evictPolicy, d.cacheOptions()...)
+
 	// Start automatic eviction if enabled
 	if d.autoEvict {
 		d.wg.Add(1)
@@ -57,8 +92,8 @@ func NewDedupe(sizeLimit int, timeLimit time.Duration, countLimit int, opts ...O
 // NewDedupeWithEvictPolicy initializes a new deduplication handler with a custom eviction policy.
 func NewDedupeWithEvictPolicy(evictPolicy *EvictionPolicy, opts ...Option) *Dedupe {
 	d := &Dedupe{
-		cache:       NewCache(evictPolicy),
 		evictPolicy: evictPolicy,
+		clock:       set.RealClock(),
 	}
 
 	// Apply functional options
@@ -66,6 +101,8 @@ func NewDedupeWithEvictPolicy(evictPolicy *EvictionPolicy, opts ...Option) *Dedu
 		opt(d)
 	}
 
+	d.cache = NewCache(evictPolicy, d.cacheOptions()...)
+
 	// Start automatic eviction if enabled
 	if d.autoEvict {
 		d.wg.Add(1)
@@ -75,15 +112,24 @@ func NewDedupeWithEvictPolicy(evictPolicy *EvictionPolicy, opts ...Option) *Dedu
 	return d
 }
 
+// cacheOptions returns the cache options derived from the Dedupe options.
+func (d *Dedupe) cacheOptions() []CacheOption {
+	opts := []CacheOption{CacheOptionClock(d.clock)}
+	if d.logger != nil {
+		opts = append(opts, CacheOptionOnEvict(d.logEviction))
+	}
+	return opts
+}
+
 // autoEvictRoutine runs the eviction policy at specified intervals.
 func (d *Dedupe) autoEvictRoutine() {
 	defer d.wg.Done()
-	ticker := time.NewTicker(d.evictInterval)
+	ticker := d.clock.NewTicker(d.evictInterval)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.C():
 			d.ApplyEviction(d.evictPolicy)
 		case <-d.stopAutoEvict:
 			return
@@ -100,15 +146,34 @@ func (d *Dedupe) TriggerEviction() {
 
 // AddEvent checks for duplicates and adds the event to the cache.
 func (d *Dedupe) AddEvent(eventID string) bool {
+	return d.AddEventWithMetadata(eventID, EntryMetadata{})
+}
+
+// AddEventWithTTL checks for duplicates and adds the event to the cache with its own time limit.
+func (d *Dedupe) AddEventWithTTL(eventID string, ttl time.Duration) bool {
+	return d.AddEventWithMetadata(eventID, EntryMetadata{TTL: ttl})
+}
+
+// AddEventWithMetadata checks for duplicates and adds the event to the cache with metadata.
+// Duplicates update the cached entry's last-seen time and count.
+func (d *Dedupe) AddEventWithMetadata(eventID string, meta EntryMetadata) bool {
+	_, isNew := d.addEvent(eventID, meta)
+	return isNew
+}
+
+// addEvent adds the event to the cache and returns its updated entry and whether it was new.
+func (d *Dedupe) addEvent(eventID string, meta EntryMetadata) (Entry, bool) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	if d.cache.Has(eventID) {
-		return false // Event is a duplicate
-	}
+	return d.cache.add(eventID, meta)
+}
 
-	d.cache.Add(eventID)
-	return true // New event
+// Entry returns the cached entry for eventID, if present.
+func (d *Dedupe) Entry(eventID string) (Entry, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.cache.Entry(eventID)
 }
 
 // Middleware wraps a socketmode handler to add deduplication.
@@ -120,19 +185,35 @@ func (d *Dedupe) Middleware(next func(evt *socketmode.Event, client *socketmode.
 		}
 
 		// Extract the event ID for deduplication (prefer client_msg_id if available)
+		attrs := socketModeAttrs(evt)
 		eventID, err := ExtractEventIDFromSocketMode(evt)
 		if err != nil {
-			client.Debugf("Failed to extract event ID: %v", err)
+			d.logMiddleware(client, slog.LevelDebug, "Failed to extract event ID",
+				append(attrs, slog.String("reason", logReasonExtractionFailed), slog.String("error", err.Error()))...)
 			return
 		}
+		attrs = append(attrs, slog.String("event_id", eventID))
 
 		// Add the event to the deduplication cache
-		if !d.AddEvent(eventID) {
-			client.Debugf("Duplicate event ignored: %s", eventID)
+		meta := EntryMetadata{
+			EventType: extractEventTypeFromSocketMode(evt),
+			Source:    SourceSocketMode,
+		}
+		if retry := ExtractRetryInfoFromSocketMode(evt); retry.IsRetry() {
+			attrs = append(attrs, slog.Int("retry_attempt", retry.Attempt), slog.String("retry_reason", retry.Reason))
+		}
+		entry, isNew := d.addEvent(eventID, meta)
+		if !isNew {
+			d.logMiddleware(client, slog.LevelInfo, "Duplicate event ignored",
+				append(attrs, slog.String("reason", logReasonDuplicate), slog.Int("count", entry.Count))...)
+			if d.onDuplicate != nil {
+				d.onDuplicate(evt, entry.FirstSeen, entry.Count)
+			}
 			return
 		}
 
 		// Proceed with the actual event handler if the event is new
+		d.log(slog.LevelDebug, "Processing new event", append(attrs, slog.String("reason", logReasonNew))...)
 		next(evt, client)
 	}
 }
@@ -145,7 +226,7 @@ func (d *Dedupe) Size() int {
 }
 
 // Items returns a copy of the current items in the cache.
-func (d *Dedupe) Items() map[string]time.Time {
+func (d *Dedupe) Items() map[string]Entry {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 	return d.cache.Items()
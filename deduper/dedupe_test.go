@@ -0,0 +1,201 @@
+package deduper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper"
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/deduptest"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+)
+
+func TestAddEventDuplicates(t *testing.T) {
+	d := deduper.NewDedupe(1000, 5*time.Minute, 500)
+
+	if !d.AddEvent("event1") {
+		t.Errorf("expected first event1 to be new")
+	}
+	if d.AddEvent("event1") {
+		t.Errorf("expected second event1 to be a duplicate")
+	}
+	if got := d.Size(); got != 1 {
+		t.Errorf("expected size 1, got %d", got)
+	}
+}
+
+func TestTriggerEvictionWithFakeClock(t *testing.T) {
+	clock := deduptest.NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	d := deduper.NewDedupe(1000, 20*time.Second, 1000, deduper.OptionClock(clock))
+
+	d.AddEvent("event1")
+	clock.Advance(15 * time.Second)
+	d.AddEvent("event2")
+	clock.Advance(10 * time.Second)
+
+	d.TriggerEviction()
+
+	items := d.Items()
+	if _, ok := items["event1"]; ok {
+		t.Errorf("expected event1 to be evicted")
+	}
+	if _, ok := items["event2"]; !ok {
+		t.Errorf("expected event2 to remain")
+	}
+}
+
+func TestAutoEvictionWithFakeClock(t *testing.T) {
+	clock := deduptest.NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	d := deduper.NewDedupe(1000, 20*time.Second, 1000,
+		deduper.OptionClock(clock),
+		deduper.OptionAutoEvict(10*time.Second),
+	)
+	defer d.StopAutoEviction()
+
+	d.AddEvent("event1")
+	clock.BlockUntilTickers(1)
+	clock.Advance(30 * time.Second)
+
+	// The eviction goroutine runs asynchronously once the ticker fires.
+	deadline := time.Now().Add(time.Second)
+	for d.Size() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected automatic eviction to empty the cache, size is %d", d.Size())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestAddEventWithTTL(t *testing.T) {
+	clock := deduptest.NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	d := deduper.NewDedupe(1000, 5*time.Minute, 1000, deduper.OptionClock(clock))
+
+	d.AddEventWithTTL("short", 10*time.Second)
+	d.AddEvent("default")
+	clock.Advance(30 * time.Second)
+	d.TriggerEviction()
+
+	if _, ok := d.Entry("short"); ok {
+		t.Errorf("expected short-lived entry to be evicted")
+	}
+	if _, ok := d.Entry("default"); !ok {
+		t.Errorf("expected entry using the policy time limit to remain")
+	}
+}
+
+func TestEntryTracksDuplicates(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := deduptest.NewClock(start)
+	d := deduper.NewDedupe(1000, 5*time.Minute, 1000, deduper.OptionClock(clock))
+
+	d.AddEventWithMetadata("event1", deduper.EntryMetadata{EventType: "message", Source: deduper.SourceHTTP})
+	clock.Advance(time.Second)
+	d.AddEvent("event1")
+	clock.Advance(time.Second)
+	d.AddEvent("event1")
+
+	entry, ok := d.Entry("event1")
+	if !ok {
+		t.Fatalf("expected event1 to be cached")
+	}
+	if entry.Count != 3 {
+		t.Errorf("expected count 3, got %d", entry.Count)
+	}
+	if !entry.FirstSeen.Equal(start) {
+		t.Errorf("expected first seen %v, got %v", start, entry.FirstSeen)
+	}
+	if want := start.Add(2 * time.Second); !entry.LastSeen.Equal(want) {
+		t.Errorf("expected last seen %v, got %v", want, entry.LastSeen)
+	}
+	if entry.EventType != "message" || entry.Source != deduper.SourceHTTP {
+		t.Errorf("expected metadata from the first add, got type=%q source=%q", entry.EventType, entry.Source)
+	}
+}
+
+func TestMiddlewareOnDuplicate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := deduptest.NewClock(start)
+
+	var (
+		gotFirstSeen time.Time
+		gotCount     int
+		gotRetry     deduper.RetryInfo
+	)
+	d := deduper.NewDedupe(1000, 5*time.Minute, 1000,
+		deduper.OptionClock(clock),
+		deduper.OptionOnDuplicate(func(evt *socketmode.Event, firstSeen time.Time, count int) {
+			gotFirstSeen = firstSeen
+			gotCount = count
+			gotRetry = deduper.ExtractRetryInfoFromSocketMode(evt)
+		}),
+	)
+
+	handled := 0
+	handler := d.Middleware(func(evt *socketmode.Event, client *socketmode.Client) {
+		handled++
+	})
+	client := socketmode.New(slack.New("xoxb-test"))
+
+	newEvent := func(attempt int, reason string) *socketmode.Event {
+		return &socketmode.Event{
+			Type: socketmode.EventTypeEventsAPI,
+			Data: slackevents.EventsAPIEvent{
+				Type: slackevents.CallbackEvent,
+				InnerEvent: slackevents.EventsAPIInnerEvent{
+					Type: "message",
+					Data: &slackevents.MessageEvent{ClientMsgID: "msg-1"},
+				},
+			},
+			Request: &socketmode.Request{EnvelopeID: "env", RetryAttempt: attempt, RetryReason: reason},
+		}
+	}
+
+	handler(newEvent(0, ""), client)
+	clock.Advance(3 * time.Second)
+	handler(newEvent(1, "timeout"), client)
+
+	if handled != 1 {
+		t.Errorf("expected handler to run once, ran %d times", handled)
+	}
+	if !gotFirstSeen.Equal(start) || gotCount != 2 {
+		t.Errorf("expected firstSeen=%v count=2, got firstSeen=%v count=%d", start, gotFirstSeen, gotCount)
+	}
+	if gotRetry != (deduper.RetryInfo{Attempt: 1, Reason: "timeout"}) {
+		t.Errorf("unexpected retry info: %+v", gotRetry)
+	}
+}
+
+func TestMiddlewareLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	d := deduper.NewDedupe(1000, 5*time.Minute, 1000, deduper.OptionLogger(logger))
+
+	handler := d.Middleware(func(evt *socketmode.Event, client *socketmode.Client) {})
+	client := socketmode.New(slack.New("xoxb-test"))
+	evt := &socketmode.Event{
+		Type: socketmode.EventTypeEventsAPI,
+		Data: slackevents.EventsAPIEvent{
+			TeamID:     "T123",
+			InnerEvent: slackevents.EventsAPIInnerEvent{Type: "message", Data: &slackevents.MessageEvent{ClientMsgID: "msg-1"}},
+		},
+	}
+
+	handler(evt, client)
+	handler(evt, client)
+
+	// Only the duplicate is logged at info level
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("expected a single JSON log record, got %q: %v", buf.String(), err)
+	}
+	want := map[string]any{"event_id": "msg-1", "team_id": "T123", "event_type": "message", "reason": "duplicate"}
+	for key, value := range want {
+		if record[key] != value {
+			t.Errorf("expected %s=%v, got %v", key, value, record[key])
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// deduper/deduptest/clock.go
+
+// Package deduptest provides helpers for testing code built on the deduper and deduper/set packages.
+package deduptest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/set"
+)
+
+var _ set.Clock = (*Clock)(nil)
+
+// Clock is a manually advanced deduper.Clock (and set.Clock). Time only moves when Advance or Set is called.
+type Clock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	tickers []*ticker
+}
+
+// NewClock creates a fake clock starting at the given time.
+func NewClock(start time.Time) *Clock {
+	c := &Clock{now: start}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the clock's current time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTicker creates a ticker that fires as the clock is advanced past each interval.
+func (c *Clock) NewTicker(d time.Duration) set.Ticker {
+	if d <= 0 {
+		panic("deduptest: non-positive interval for NewTicker")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &ticker{
+		c:        make(chan time.Time, 1),
+		interval: d,
+		next:     c.now.Add(d),
+		clock:    c,
+	}
+	c.tickers = append(c.tickers, t)
+	c.cond.Broadcast()
+	return t
+}
+
+// BlockUntilTickers waits until at least n tickers are active. Use it before Advance
+// when a ticker is created by another goroutine, such as the automatic eviction routine.
+func (c *Clock) BlockUntilTickers(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.tickers) < n {
+		c.cond.Wait()
+	}
+}
+
+// Advance moves the clock forward by d and fires any tickers that became due.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(c.now.Add(d))
+}
+
+// Set moves the clock to t and fires any tickers that became due.
+// Moving the clock backwards is allowed but never fires tickers.
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setLocked(t)
+}
+
+// setLocked updates the current time and fires due tickers. The caller must hold c.mu.
+func (c *Clock) setLocked(t time.Time) {
+	c.now = t
+	for _, tk := range c.tickers {
+		if tk.next.After(t) {
+			continue
+		}
+		// Like time.Ticker, drop ticks for slow receivers rather than blocking.
+		select {
+		case tk.c <- t:
+		default:
+		}
+		for !tk.next.After(t) {
+			tk.next = tk.next.Add(tk.interval)
+		}
+	}
+}
+
+// removeTicker stops delivering ticks to t.
+func (c *Clock) removeTicker(t *ticker) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, tk := range c.tickers {
+		if tk == t {
+			c.tickers = append(c.tickers[:i], c.tickers[i+1:]...)
+			c.cond.Broadcast()
+			return
+		}
+	}
+}
+
+// ticker is the Ticker returned by Clock.NewTicker.
+type ticker struct {
+	c        chan time.Time
+	interval time.Duration
+	next     time.Time
+	clock    *Clock
+}
+
+// C returns the ticker's channel.
+func (t *ticker) C() <-chan time.Time {
+	return t.c
+}
+
+// Stop turns off the ticker.
+func (t *ticker) Stop() {
+	t.clock.removeTicker(t)
+}
@@ -0,0 +1,80 @@
+// deduper/entry.go
+
+package deduper
+
+import (
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/set"
+)
+
+// Source identifies how an event was delivered to the application.
+type Source string
+
+// Known event sources.
+const (
+	SourceUnknown    Source = ""
+	SourceSocketMode Source = "socketmode"
+	SourceHTTP       Source = "http"
+)
+
+// Entry describes a cached event ID.
+type Entry struct {
+	FirstSeen time.Time     `json:"first_seen"`           // When the event was first added.
+	LastSeen  time.Time     `json:"last_seen"`            // When the event was most recently seen, including duplicates.
+	Count     int           `json:"count"`                // Number of deliveries, including the first.
+	TTL       time.Duration `json:"ttl,omitempty"`        // Per-entry time limit; zero uses the policy's time limit.
+	EventType string        `json:"event_type,omitempty"` // Slack event type, e.g. "message".
+	Source    Source        `json:"source,omitempty"`     // How the event was delivered.
+}
+
+// EntryMetadata holds optional information recorded when an event is first added.
+type EntryMetadata struct {
+	TTL       time.Duration // Per-entry time limit; zero uses the policy's time limit.
+	EventType string        // Slack event type, e.g. "message".
+	Source    Source        // How the event was delivered.
+}
+
+// EvictReason describes why a cached event ID was evicted.
+type EvictReason = set.EvictReason
+
+// Eviction reasons passed to CacheOptionOnEvict handlers.
+const (
+	EvictReasonExpired    = set.EvictReasonExpired
+	EvictReasonCountLimit = set.EvictReasonCountLimit
+)
+
+// eventMeta is the Slack-specific part of an entry, stored in set.Entry.Meta.
+type eventMeta struct {
+	EventType string
+	Source    Source
+}
+
+// setMetadata converts meta to the metadata stored in the underlying set.
+func (meta EntryMetadata) setMetadata() set.Metadata {
+	return set.Metadata{TTL: meta.TTL, Meta: eventMeta{EventType: meta.EventType, Source: meta.Source}}
+}
+
+// setEntry converts entry to the entry stored in the underlying set.
+func (entry Entry) setEntry() set.Entry {
+	return set.Entry{
+		FirstSeen: entry.FirstSeen,
+		LastSeen:  entry.LastSeen,
+		Count:     entry.Count,
+		TTL:       entry.TTL,
+		Meta:      eventMeta{EventType: entry.EventType, Source: entry.Source},
+	}
+}
+
+// entryFromSet converts an entry from the underlying set.
+func entryFromSet(entry set.Entry) Entry {
+	meta, _ := entry.Meta.(eventMeta)
+	return Entry{
+		FirstSeen: entry.FirstSeen,
+		LastSeen:  entry.LastSeen,
+		Count:     entry.Count,
+		TTL:       entry.TTL,
+		EventType: meta.EventType,
+		Source:    meta.Source,
+	}
+}
@@ -2,6 +2,8 @@ package deduper
 
 import (
 	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/set"
 )
 
 // EvictionPolicy defines rules for removing stale entries.
@@ -9,35 +11,47 @@ type EvictionPolicy struct {
 	sizeLimit  int
 	timeLimit  time.Duration
 	countLimit int
+	clock      Clock
+}
+
+// EvictionPolicyOption defines a functional option for EvictionPolicy.
+type EvictionPolicyOption func(*EvictionPolicy)
+
+// EvictionPolicyOptionClock sets the clock used to decide whether items have expired.
+func EvictionPolicyOptionClock(clock Clock) EvictionPolicyOption {
+	return func(e *EvictionPolicy) {
+		e.clock = clock
+	}
 }
 
 // NewEvictionPolicy creates a new eviction policy.
-func NewEvictionPolicy(sizeLimit int, timeLimit time.Duration, countLimit int) *EvictionPolicy {
-	return &EvictionPolicy{
+// A zero timeLimit or countLimit disables that limit; earlier versions evicted every
+// entry when either limit was zero.
+func NewEvictionPolicy(sizeLimit int, timeLimit time.Duration, countLimit int, opts ...EvictionPolicyOption) *EvictionPolicy {
+	e := &EvictionPolicy{
 		sizeLimit:  sizeLimit,
 		timeLimit:  timeLimit,
 		countLimit: countLimit,
+		clock:      set.RealClock(),
 	}
+
+	// Apply functional options
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
 }
 
 // Apply evicts items that violate the policy.
 func (e *EvictionPolicy) Apply(c *Cache) {
-	now := time.Now()
-
-	// Evict based on time limit
-	for id, t := range c.items {
-		if now.Sub(t) > e.timeLimit {
-			delete(c.items, id)
-		}
-	}
+	c.set.EvictWithPolicy(e.setPolicy(), e.clock.Now())
+}
 
-	// Evict based on count limit
-	if len(c.items) > e.countLimit {
-		for id := range c.items {
-			delete(c.items, id)
-			if len(c.items) <= e.countLimit {
-				break
-			}
-		}
+// setPolicy returns the time and count limits as a set.Policy.
+func (e *EvictionPolicy) setPolicy() set.Policy {
+	return set.Policy{
+		TimeLimit:  e.timeLimit,
+		CountLimit: e.countLimit,
 	}
 }
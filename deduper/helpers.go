@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 
 	"github.com/slack-go/slack/slackevents"
 	"github.com/slack-go/slack/socketmode"
@@ -19,6 +21,13 @@ func ExtractEventIDFromSocketMode(evt *socketmode.Event) (string, error) {
 		return "", errors.New("invalid event in socketmode event")
 	}
 
+	return ExtractEventIDFromEventsAPI(event)
+}
+
+// ExtractEventIDFromEventsAPI extracts a stable event ID from an Events API payload,
+// such as one received over HTTP and parsed with slackevents.ParseEvent.
+// Uses client_msg_id for message events, otherwise hashes key event fields.
+func ExtractEventIDFromEventsAPI(event slackevents.EventsAPIEvent) (string, error) {
 	// Prefer client_msg_id for message events
 	if messageEvent, ok := event.InnerEvent.Data.(*slackevents.MessageEvent); ok && messageEvent.ClientMsgID != "" {
 		return messageEvent.ClientMsgID, nil
@@ -97,3 +106,46 @@ func ExtractEnvelopeIDFromSocketMode(evt *socketmode.Event) (string, error) {
 	// Return the envelope_id for deduplication
 	return evId, nil
 }
+
+// RetryInfo describes a redelivery of an event by Slack.
+type RetryInfo struct {
+	Attempt int    // Retry attempt number; zero for the original delivery.
+	Reason  string // Why Slack retried, e.g. "timeout" or "http_timeout".
+}
+
+// IsRetry reports whether the event was a redelivery.
+func (r RetryInfo) IsRetry() bool {
+	return r.Attempt > 0
+}
+
+// ExtractRetryInfoFromSocketMode returns the retry_attempt and retry_reason of a socketmode request.
+func ExtractRetryInfoFromSocketMode(evt *socketmode.Event) RetryInfo {
+	if evt.Request == nil {
+		return RetryInfo{}
+	}
+	return RetryInfo{
+		Attempt: evt.Request.RetryAttempt,
+		Reason:  evt.Request.RetryReason,
+	}
+}
+
+// ExtractRetryInfoFromHTTP returns the retry information from the X-Slack-Retry-Num and
+// X-Slack-Retry-Reason headers of an Events API HTTP request.
+func ExtractRetryInfoFromHTTP(header http.Header) RetryInfo {
+	attempt, err := strconv.Atoi(header.Get("X-Slack-Retry-Num"))
+	if err != nil {
+		attempt = 0
+	}
+	return RetryInfo{
+		Attempt: attempt,
+		Reason:  header.Get("X-Slack-Retry-Reason"),
+	}
+}
+
+// extractEventTypeFromSocketMode returns the inner Events API event type, falling back to the socketmode event type.
+func extractEventTypeFromSocketMode(evt *socketmode.Event) string {
+	if event, ok := evt.Data.(slackevents.EventsAPIEvent); ok && event.InnerEvent.Type != "" {
+		return event.InnerEvent.Type
+	}
+	return string(evt.Type)
+}
@@ -0,0 +1,70 @@
+// deduper/logging.go
+
+package deduper
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+)
+
+// Reasons recorded in the "reason" attribute of log records.
+const (
+	logReasonNew              = "new"
+	logReasonDuplicate        = "duplicate"
+	logReasonExtractionFailed = "extraction_failed"
+)
+
+// log writes a record to the configured logger. It is a no-op without OptionLogger.
+func (d *Dedupe) log(level slog.Level, msg string, attrs ...slog.Attr) {
+	if d.logger == nil {
+		return
+	}
+	d.logger.LogAttrs(context.Background(), level, msg, attrs...)
+}
+
+// logMiddleware writes a middleware decision to the configured logger,
+// falling back to the socketmode client's debug log when no logger is set.
+func (d *Dedupe) logMiddleware(client *socketmode.Client, level slog.Level, msg string, attrs ...slog.Attr) {
+	if d.logger == nil {
+		client.Debugf("%s: %s", msg, formatAttrs(attrs))
+		return
+	}
+	d.log(level, msg, attrs...)
+}
+
+// logEviction is the cache eviction handler installed when a logger is configured.
+func (d *Dedupe) logEviction(eventID string, entry Entry, reason EvictReason) {
+	d.log(slog.LevelDebug, "Evicted event",
+		slog.String("event_id", eventID),
+		slog.String("event_type", entry.EventType),
+		slog.String("source", string(entry.Source)),
+		slog.Int("count", entry.Count),
+		slog.String("reason", string(reason)),
+	)
+}
+
+// socketModeAttrs returns the attributes identifying a socketmode event.
+func socketModeAttrs(evt *socketmode.Event) []slog.Attr {
+	var attrs []slog.Attr
+	if evt.Request != nil {
+		attrs = append(attrs, slog.String("envelope_id", evt.Request.EnvelopeID))
+	}
+	if event, ok := evt.Data.(slackevents.EventsAPIEvent); ok {
+		attrs = append(attrs, slog.String("team_id", event.TeamID))
+	}
+	return append(attrs, slog.String("event_type", extractEventTypeFromSocketMode(evt)))
+}
+
+// formatAttrs renders attrs as space-separated key=value pairs.
+func formatAttrs(attrs []slog.Attr) string {
+	parts := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		parts = append(parts, fmt.Sprintf("%s=%v", attr.Key, attr.Value))
+	}
+	return strings.Join(parts, " ")
+}
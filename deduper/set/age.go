@@ -0,0 +1,41 @@
+// deduper/set/age.go
+
+package set
+
+// item is a key in a Set together with its entry and position in the age heap.
+type item[K comparable] struct {
+	key   K
+	entry Entry
+	index int
+}
+
+// ageHeap orders items by first-seen time, oldest first, so count-based eviction
+// does not need to sort the set. It implements container/heap.Interface.
+type ageHeap[K comparable] []*item[K]
+
+func (h ageHeap[K]) Len() int { return len(h) }
+
+func (h ageHeap[K]) Less(i, j int) bool {
+	return h[i].entry.FirstSeen.Before(h[j].entry.FirstSeen)
+}
+
+func (h ageHeap[K]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *ageHeap[K]) Push(x any) {
+	it := x.(*item[K])
+	it.index = len(*h)
+	*h = append(*h, it)
+}
+
+func (h *ageHeap[K]) Pop() any {
+	old := *h
+	n := len(old)
+	it := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return it
+}
@@ -0,0 +1,52 @@
+// deduper/set/clock.go
+
+package set
+
+import (
+	"time"
+)
+
+// Clock provides the current time and tickers so tests can control the passage of time.
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks at intervals, mirroring time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// RealClock returns the Clock backed by the time package.
+func RealClock() Clock {
+	return realClock{}
+}
+
+// realClock is the default Clock backed by the time package.
+type realClock struct{}
+
+// Now returns time.Now().
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTicker returns a ticker backed by time.NewTicker.
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+// realTicker adapts *time.Ticker to the Ticker interface.
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+// C returns the ticker's channel.
+func (t realTicker) C() <-chan time.Time {
+	return t.ticker.C
+}
+
+// Stop turns off the ticker.
+func (t realTicker) Stop() {
+	t.ticker.Stop()
+}
@@ -0,0 +1,41 @@
+// deduper/set/entry.go
+
+package set
+
+import (
+	"time"
+)
+
+// Entry describes an item in a Set.
+type Entry struct {
+	FirstSeen time.Time     `json:"first_seen"`     // When the item was first added.
+	LastSeen  time.Time     `json:"last_seen"`      // When the item was most recently seen, including duplicates.
+	Count     int           `json:"count"`          // Number of times the item was seen, including the first.
+	TTL       time.Duration `json:"ttl,omitempty"`  // Per-entry time limit; zero uses the policy's time limit.
+	Meta      any           `json:"meta,omitempty"` // Application-defined value recorded when the item was first added.
+}
+
+// Metadata holds optional information recorded when an item is first added.
+type Metadata struct {
+	TTL  time.Duration // Per-entry time limit; zero uses the policy's time limit.
+	Meta any           // Application-defined value stored in Entry.Meta.
+}
+
+// Policy defines when items are evicted from a Set.
+type Policy struct {
+	TimeLimit  time.Duration // Maximum age of an item, measured from FirstSeen. Zero disables the limit.
+	CountLimit int           // Maximum number of items; the oldest are evicted first. Zero disables the limit.
+}
+
+// Expired reports whether entry is past its time limit at now.
+// Entries with a TTL use it in place of the policy's time limit.
+func (p Policy) Expired(now time.Time, entry Entry) bool {
+	limit := p.TimeLimit
+	if entry.TTL > 0 {
+		limit = entry.TTL
+	}
+	if limit <= 0 {
+		return false
+	}
+	return now.Sub(entry.FirstSeen) > limit
+}
@@ -0,0 +1,283 @@
+// deduper/set/set.go
+
+// Package set provides a generic, concurrency-safe seen-set with time and count based
+// eviction. It has no Slack dependency; the deduper package builds its Slack event
+// deduplication on top of it.
+package set
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// Set records keys that have been seen and evicts them according to a Policy.
+type Set[K comparable] struct {
+	items   map[K]*item[K]
+	ages    ageHeap[K]
+	mu      sync.RWMutex
+	policy  Policy
+	clock   Clock
+	onEvict func(key K, entry Entry, reason EvictReason)
+
+	autoEvict     bool
+	evictInterval time.Duration
+	stopAutoEvict chan struct{}
+	stopOnce      sync.Once
+	wg            sync.WaitGroup
+}
+
+// EvictReason describes why a key was evicted.
+type EvictReason string
+
+// Eviction reasons passed to OnEvict handlers.
+const (
+	EvictReasonExpired    EvictReason = "expired"
+	EvictReasonCountLimit EvictReason = "count_limit"
+)
+
+// options holds the settings applied by Option.
+type options struct {
+	clock         Clock
+	autoEvict     bool
+	evictInterval time.Duration
+}
+
+// Option defines a functional option for Set.
+type Option func(*options)
+
+// OptionClock sets the clock used for timestamps, expiry and the automatic eviction ticker.
+func OptionClock(clock Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
+// OptionAutoEvict enables automatic eviction with a specified interval.
+// Call Stop to end the eviction goroutine.
+func OptionAutoEvict(interval time.Duration) Option {
+	return func(o *options) {
+		o.autoEvict = true
+		o.evictInterval = interval
+	}
+}
+
+// New initializes a new set with the given eviction policy.
+func New[K comparable](policy Policy, opts ...Option) *Set[K] {
+	o := options{clock: realClock{}}
+
+	// Apply functional options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	s := &Set[K]{
+		items:         make(map[K]*item[K]),
+		policy:        policy,
+		clock:         o.clock,
+		autoEvict:     o.autoEvict,
+		evictInterval: o.evictInterval,
+	}
+
+	// Start automatic eviction if enabled
+	if s.autoEvict {
+		s.stopAutoEvict = make(chan struct{})
+		s.wg.Add(1)
+		go s.autoEvictRoutine()
+	}
+
+	return s
+}
+
+// OnEvict registers a handler called for every key removed by eviction and returns the set.
+// The handler runs while the set is locked and must not call back into the set.
+func (s *Set[K]) OnEvict(handler func(key K, entry Entry, reason EvictReason)) *Set[K] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onEvict = handler
+	return s
+}
+
+// autoEvictRoutine runs the eviction policy at specified intervals.
+func (s *Set[K]) autoEvictRoutine() {
+	defer s.wg.Done()
+	ticker := s.clock.NewTicker(s.evictInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C():
+			s.Evict()
+		case <-s.stopAutoEvict:
+			return
+		}
+	}
+}
+
+// Stop stops the automatic eviction goroutine, if it was enabled.
+func (s *Set[K]) Stop() {
+	if s.autoEvict {
+		s.stopOnce.Do(func() { close(s.stopAutoEvict) })
+		s.wg.Wait()
+	}
+}
+
+// Add records key and reports whether it was new.
+func (s *Set[K]) Add(key K) bool {
+	_, isNew := s.AddWithMetadata(key, Metadata{})
+	return isNew
+}
+
+// AddWithTTL records key with its own time limit and reports whether it was new.
+func (s *Set[K]) AddWithTTL(key K, ttl time.Duration) bool {
+	_, isNew := s.AddWithMetadata(key, Metadata{TTL: ttl})
+	return isNew
+}
+
+// AddWithMetadata records key and returns its entry and whether it was new.
+// If key is already present, its last-seen time and count are updated and meta is ignored.
+// The eviction policy is applied after the key is recorded.
+func (s *Set[K]) AddWithMetadata(key K, meta Metadata) (Entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	it, exists := s.items[key]
+	if exists {
+		it.entry.LastSeen = now
+		it.entry.Count++
+	} else {
+		it = s.insertLocked(key, Entry{
+			FirstSeen: now,
+			LastSeen:  now,
+			Count:     1,
+			TTL:       meta.TTL,
+			Meta:      meta.Meta,
+		})
+	}
+	entry := it.entry
+	s.evictLocked(s.policy, now)
+
+	return entry, !exists
+}
+
+// Load inserts an entry with known timestamps, e.g. one read from a snapshot.
+// Existing keys and entries already past the policy's time limit are skipped.
+// It reports whether the entry was inserted; the count limit is not applied.
+func (s *Set[K]) Load(key K, entry Entry) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.items[key]; exists || s.policy.Expired(s.clock.Now(), entry) {
+		return false
+	}
+	s.insertLocked(key, entry)
+	return true
+}
+
+// insertLocked adds a new key to the set and the age heap.
+// The caller must hold s.mu.
+func (s *Set[K]) insertLocked(key K, entry Entry) *item[K] {
+	it := &item[K]{key: key, entry: entry}
+	s.items[key] = it
+	heap.Push(&s.ages, it)
+	return it
+}
+
+// Has checks if key is present.
+func (s *Set[K]) Has(key K) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, exists := s.items[key]
+	return exists
+}
+
+// Entry returns the entry for key, if present.
+func (s *Set[K]) Entry(key K) (Entry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if it, exists := s.items[key]; exists {
+		return it.entry, true
+	}
+	return Entry{}, false
+}
+
+// Remove deletes key from the set.
+func (s *Set[K]) Remove(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if it, exists := s.items[key]; exists {
+		s.deleteLocked(it)
+	}
+}
+
+// Len returns the number of keys in the set.
+func (s *Set[K]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.items)
+}
+
+// Items returns a copy of the current entries in the set.
+func (s *Set[K]) Items() map[K]Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	copiedItems := make(map[K]Entry, len(s.items))
+	for k, it := range s.items {
+		copiedItems[k] = it.entry
+	}
+	return copiedItems
+}
+
+// Evict applies the set's policy and returns the number of keys removed.
+func (s *Set[K]) Evict() int {
+	return s.EvictWithPolicy(s.policy, s.clock.Now())
+}
+
+// EvictWithPolicy applies policy as of now instead of the set's own policy and clock,
+// and returns the number of keys removed.
+func (s *Set[K]) EvictWithPolicy(policy Policy, now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.evictLocked(policy, now)
+}
+
+// evictLocked removes expired keys, then the oldest keys over the count limit.
+// The caller must hold s.mu.
+func (s *Set[K]) evictLocked(policy Policy, now time.Time) int {
+	removed := 0
+
+	// Evict based on time limit
+	for _, it := range s.items {
+		if policy.Expired(now, it.entry) {
+			s.removeLocked(it, EvictReasonExpired)
+			removed++
+		}
+	}
+
+	// Evict based on count limit, oldest first
+	for policy.CountLimit > 0 && len(s.items) > policy.CountLimit {
+		s.removeLocked(s.ages[0], EvictReasonCountLimit)
+		removed++
+	}
+
+	return removed
+}
+
+// removeLocked deletes an evicted item and notifies the eviction handler.
+// The caller must hold s.mu.
+func (s *Set[K]) removeLocked(it *item[K], reason EvictReason) {
+	s.deleteLocked(it)
+	if s.onEvict != nil {
+		s.onEvict(it.key, it.entry, reason)
+	}
+}
+
+// deleteLocked removes an item from the set and the age heap.
+// The caller must hold s.mu.
+func (s *Set[K]) deleteLocked(it *item[K]) {
+	delete(s.items, it.key)
+	heap.Remove(&s.ages, it.index)
+}
@@ -0,0 +1,105 @@
+package set_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/deduptest"
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/set"
+)
+
+func TestSetAdd(t *testing.T) {
+	s := set.New[int](set.Policy{TimeLimit: time.Minute})
+
+	if !s.Add(1) {
+		t.Errorf("expected first add of 1 to be new")
+	}
+	if s.Add(1) {
+		t.Errorf("expected second add of 1 to be a duplicate")
+	}
+	if entry, _ := s.Entry(1); entry.Count != 2 {
+		t.Errorf("expected count 2, got %d", entry.Count)
+	}
+}
+
+func TestSetEviction(t *testing.T) {
+	clock := deduptest.NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := set.New[string](set.Policy{TimeLimit: 20 * time.Second, CountLimit: 2}, set.OptionClock(clock))
+
+	s.Add("a")
+	clock.Advance(time.Second)
+	s.Add("b")
+	clock.Advance(time.Second)
+	s.Add("c") // Over the count limit, so the oldest key is evicted
+
+	if s.Has("a") {
+		t.Errorf("expected oldest key to be evicted by the count limit")
+	}
+
+	clock.Advance(20 * time.Second)
+	if removed := s.Evict(); removed != 1 {
+		t.Errorf("expected 1 key to expire, got %d", removed)
+	}
+	if s.Has("b") || !s.Has("c") {
+		t.Errorf("expected only c to remain, got %v", s.Items())
+	}
+}
+
+func TestSetOnEvict(t *testing.T) {
+	clock := deduptest.NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	evicted := map[int]set.EvictReason{}
+	s := set.New[int](set.Policy{TimeLimit: time.Minute, CountLimit: 2}, set.OptionClock(clock)).
+		OnEvict(func(key int, _ set.Entry, reason set.EvictReason) {
+			evicted[key] = reason
+		})
+
+	s.Add(1)
+	clock.Advance(time.Second)
+	s.Add(2)
+	s.Add(3)
+	clock.Advance(2 * time.Minute)
+	s.Evict()
+
+	want := map[int]set.EvictReason{1: set.EvictReasonCountLimit, 2: set.EvictReasonExpired, 3: set.EvictReasonExpired}
+	for key, reason := range want {
+		if evicted[key] != reason {
+			t.Errorf("key %d evicted with %q, want %q", key, evicted[key], reason)
+		}
+	}
+}
+
+func TestSetCountLimitOrder(t *testing.T) {
+	clock := deduptest.NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := set.New[string](set.Policy{CountLimit: 3}, set.OptionClock(clock))
+
+	s.Add("b")
+	clock.Advance(time.Second)
+	s.Add("c")
+	s.Load("a", set.Entry{FirstSeen: clock.Now().Add(-time.Hour), Count: 1})
+	s.Remove("c")
+	clock.Advance(time.Second)
+	s.Add("d")
+	s.Add("b") // A duplicate keeps its original first-seen time
+	clock.Advance(time.Second)
+	s.Add("e")
+	s.Add("f")
+
+	for _, key := range []string{"a", "b", "c"} {
+		if s.Has(key) {
+			t.Errorf("expected %s to be evicted, got %v", key, s.Items())
+		}
+	}
+	if s.Len() != 3 {
+		t.Errorf("expected 3 keys, got %d", s.Len())
+	}
+}
+
+func TestSetMeta(t *testing.T) {
+	s := set.New[string](set.Policy{})
+
+	s.AddWithMetadata("a", set.Metadata{Meta: "first"})
+	entry, isNew := s.AddWithMetadata("a", set.Metadata{Meta: "second"})
+	if isNew || entry.Meta != "first" {
+		t.Errorf("expected the first metadata to be kept, got %v (new %v)", entry.Meta, isNew)
+	}
+}
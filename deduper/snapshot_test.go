@@ -0,0 +1,71 @@
+package deduper_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	src := deduper.NewDedupe(1000, 5*time.Minute, 500)
+	for _, id := range []string{"event1", "event2", "event3", "event1"} {
+		src.AddEvent(id)
+	}
+
+	var buf bytes.Buffer
+	if err := src.Snapshot(&buf); err != nil {
+		t.Fatalf("Snapshot returned error: %v", err)
+	}
+
+	dst := deduper.NewDedupe(1000, 5*time.Minute, 500)
+	if err := dst.Restore(&buf); err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+
+	want := src.Items()
+	got := dst.Items()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d items, got %d", len(want), len(got))
+	}
+	for id, entry := range want {
+		if !got[id].FirstSeen.Equal(entry.FirstSeen) {
+			t.Errorf("item %s: expected first seen %v, got %v", id, entry.FirstSeen, got[id].FirstSeen)
+		}
+		if got[id].Count != entry.Count {
+			t.Errorf("item %s: expected count %d, got %d", id, entry.Count, got[id].Count)
+		}
+	}
+	if dst.AddEvent("event1") {
+		t.Errorf("expected restored event1 to be a duplicate")
+	}
+}
+
+func TestRestoreSkipsStaleEntries(t *testing.T) {
+	fresh := time.Now().Add(-time.Minute).Format(time.RFC3339Nano)
+	stale := time.Now().Add(-time.Hour).Format(time.RFC3339Nano)
+	input := fmt.Sprintf(`{"version":1,"items":[{"id":"fresh","first_seen":%[1]q,"last_seen":%[1]q,"count":1},{"id":"stale","first_seen":%[2]q,"last_seen":%[2]q,"count":1}]}`, fresh, stale)
+
+	d := deduper.NewDedupe(1000, 5*time.Minute, 500)
+	if err := d.Restore(strings.NewReader(input)); err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+
+	items := d.Items()
+	if _, ok := items["fresh"]; !ok {
+		t.Errorf("expected fresh entry to be restored")
+	}
+	if _, ok := items["stale"]; ok {
+		t.Errorf("expected stale entry to be skipped")
+	}
+}
+
+func TestRestoreRejectsUnknownVersion(t *testing.T) {
+	d := deduper.NewDedupe(1000, 5*time.Minute, 500)
+	if err := d.Restore(strings.NewReader(`{"version":99,"items":[]}`)); err == nil {
+		t.Errorf("expected error for unsupported snapshot version")
+	}
+}
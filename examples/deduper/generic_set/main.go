@@ -0,0 +1,49 @@
+// examples/deduper/generic_set/main.go
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/deduper/set"
+)
+
+// sqsMessage stands in for a message received from a non-Slack queue.
+type sqsMessage struct {
+	ID   string
+	Body string
+}
+
+func main() {
+	// A set keyed by message ID, remembering IDs for 10 minutes and at most 10000 of them
+	seen := set.New[string](
+		set.Policy{TimeLimit: 10 * time.Minute, CountLimit: 10000},
+		set.OptionAutoEvict(time.Minute),
+	)
+	defer seen.Stop() // Ensure the eviction goroutine is stopped when main exits
+
+	messages := []sqsMessage{
+		{ID: "m-1", Body: "deploy started"},
+		{ID: "m-2", Body: "deploy finished"},
+		{ID: "m-1", Body: "deploy started"}, // Redelivered
+	}
+
+	for _, msg := range messages {
+		if !seen.AddWithTTL(msg.ID, 5*time.Minute) {
+			fmt.Printf("Duplicate message ignored: %s\n", msg.ID)
+			continue
+		}
+		fmt.Printf("Processed message %s: %s\n", msg.ID, msg.Body)
+	}
+
+	entry, _ := seen.Entry("m-1")
+	fmt.Printf("m-1 was seen %d times\n", entry.Count)
+}
+
+// Expected Output:
+// $ go run examples/deduper/generic_set/main.go
+// Processed message m-1: deploy started
+// Processed message m-2: deploy finished
+// Duplicate message ignored: m-1
+// m-1 was seen 2 times
@@ -0,0 +1,44 @@
+package mrkdwn
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date format tokens, combined with other text in the format passed to Date.
+const (
+	DateNum         = "{date_num}"          // 2014-02-18
+	DateSlash       = "{date_slash}"        // 02/18/2014
+	DateLong        = "{date_long}"         // Tuesday, February 18th, 2014
+	DateLongFull    = "{date_long_full}"    // February 18th, 2014
+	DateLongPretty  = "{date_long_pretty}"  // yesterday, Tuesday, February 18th, 2014
+	DateDefault     = "{date}"              // February 18th, 2014
+	DatePretty      = "{date_pretty}"       // yesterday, February 18th, 2014
+	DateShort       = "{date_short}"        // Feb 18, 2014
+	DateShortPretty = "{date_short_pretty}" // yesterday, Feb 18, 2014
+	Time            = "{time}"              // 6:39 AM
+	TimeSecs        = "{time_secs}"         // 6:39:42 AM
+	Ago             = "{ago}"               // 3 minutes ago
+)
+
+// Date shows t in each reader's own time zone using format, e.g. mrkdwn.DateShort + " at " + mrkdwn.Time.
+// Clients that cannot render the token show t in UTC.
+func Date(t time.Time, format string) Text {
+	return DateWithFallback(t, format, t.UTC().Format("2006-01-02 15:04 UTC"))
+}
+
+// DateWithFallback is like Date with the text shown by clients that cannot render the token.
+func DateWithFallback(t time.Time, format, fallback string) Text {
+	return "<!date^" + Text(strconv.FormatInt(t.Unix(), 10)) + "^" + Escape(format) + "|" + Escape(fallback) + ">"
+}
+
+// dateURLEscaper percent-encodes the characters that delimit a date token.
+var dateURLEscaper = strings.NewReplacer("|", "%7C", "^", "%5E", ">", "%3E")
+
+// DateLink is like Date and links the rendered date to url.
+// The characters |, ^ and > are percent-encoded so url cannot end the token early.
+func DateLink(t time.Time, format, url string) Text {
+	return "<!date^" + Text(strconv.FormatInt(t.Unix(), 10)) + "^" + Escape(format) + "^" + Escape(dateURLEscaper.Replace(url)) +
+		"|" + Escape(t.UTC().Format("2006-01-02 15:04 UTC")) + ">"
+}
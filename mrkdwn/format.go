@@ -0,0 +1,80 @@
+package mrkdwn
+
+import (
+	"strings"
+)
+
+// Link links url with a label. An empty label shows the URL. A | in url is percent-encoded,
+// since Slack would end the URL there.
+func Link(url, label string) Text {
+	target := Escape(strings.ReplaceAll(url, "|", "%7C"))
+	if label == "" {
+		return "<" + target + ">"
+	}
+	return "<" + target + "|" + Escape(label) + ">"
+}
+
+// Email links an email address.
+func Email(address, label string) Text {
+	return Link("mailto:"+address, label)
+}
+
+// Bold makes text bold.
+func Bold(text string) Text {
+	return wrap("*", text)
+}
+
+// Italic makes text italic.
+func Italic(text string) Text {
+	return wrap("_", text)
+}
+
+// Strike strikes text through.
+func Strike(text string) Text {
+	return wrap("~", text)
+}
+
+// Code shows text as inline code. Backticks, which would end the code early,
+// are replaced with ˋ (U+02CB).
+func Code(text string) Text {
+	return wrap("`", noBackticks(text))
+}
+
+// CodeBlock shows text as a preformatted block. Runs of three backticks are broken up
+// so they cannot close the block.
+func CodeBlock(text string) Text {
+	text = strings.ReplaceAll(text, "```", "``ˋ")
+	return "```\n" + Escape(text) + "\n```"
+}
+
+// Quote shows every line of text as a block quote.
+func Quote(text string) Text {
+	lines := strings.Split(string(Escape(text)), "\n")
+	for i, line := range lines {
+		lines[i] = "> " + line
+	}
+	return Text(strings.Join(lines, "\n"))
+}
+
+// Emoji shows an emoji by name, with or without surrounding colons, e.g. "rocket".
+func Emoji(name string) Text {
+	return ":" + Escape(strings.Trim(name, ":")) + ":"
+}
+
+// wrap escapes text and surrounds it with marker, keeping surrounding whitespace outside the
+// markers, where Slack requires it.
+func wrap(marker, text string) Text {
+	escaped := string(Escape(text))
+	core := strings.TrimSpace(escaped)
+	if core == "" {
+		return Text(escaped)
+	}
+	lead := escaped[:strings.Index(escaped, core)]
+	trail := escaped[len(lead)+len(core):]
+	return Text(lead + marker + core + marker + trail)
+}
+
+// noBackticks replaces backticks with a look-alike.
+func noBackticks(text string) string {
+	return strings.ReplaceAll(text, "`", "ˋ")
+}
@@ -0,0 +1,31 @@
+package mrkdwn
+
+// User mentions a user by ID, e.g. U0123456.
+func User(userID string) Text {
+	return "<@" + Escape(userID) + ">"
+}
+
+// Channel links to a channel by ID, e.g. C0123456.
+func Channel(channelID string) Text {
+	return "<#" + Escape(channelID) + ">"
+}
+
+// UserGroup mentions a user group by ID, e.g. S0123456.
+func UserGroup(usergroupID string) Text {
+	return "<!subteam^" + Escape(usergroupID) + ">"
+}
+
+// Here notifies the active members of the channel.
+func Here() Text {
+	return "<!here>"
+}
+
+// ChannelMention notifies every member of the channel.
+func ChannelMention() Text {
+	return "<!channel>"
+}
+
+// Everyone notifies every member of the workspace; it only works in #general.
+func Everyone() Text {
+	return "<!everyone>"
+}
@@ -0,0 +1,77 @@
+// Package mrkdwn composes Slack mrkdwn text safely. Untrusted strings are escaped so they
+// cannot inject mentions such as <!channel> or links, while helpers build the mentions,
+// links, dates and formatting that should be interpreted.
+package mrkdwn
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Text is mrkdwn that is safe to send as is: either built by this package or escaped.
+// Plain strings passed to Concat and Sprintf are escaped; Text values are not.
+type Text string
+
+// String returns the mrkdwn as a string, e.g. for blockbuilder.NewSectionBlock.
+func (t Text) String() string {
+	return string(t)
+}
+
+// escaper replaces the characters Slack reserves for control sequences.
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Escape escapes &, < and > so text is shown literally and cannot trigger mentions or links.
+// Formatting characters such as * and _ have no escape in mrkdwn and are left as they are.
+func Escape(text string) Text {
+	return Text(escaper.Replace(text))
+}
+
+// Raw marks text as trusted mrkdwn without escaping it.
+func Raw(text string) Text {
+	return Text(text)
+}
+
+// Concat joins parts into one Text. Text parts are kept as they are and everything else is
+// formatted with fmt.Sprint and escaped.
+func Concat(parts ...any) Text {
+	var b strings.Builder
+	for _, part := range parts {
+		b.WriteString(string(safe(part)))
+	}
+	return Text(b.String())
+}
+
+// Sprintf formats like fmt.Sprintf with a trusted format string. Every argument that is not
+// Text is formatted with its verb and then escaped, so %q, %x and %c cannot smuggle in < or &.
+// %T and widths or precisions taken from * arguments are not supported.
+//
+//	mrkdwn.Sprintf("%s deployed %s", mrkdwn.User(userID), untrustedVersion)
+func Sprintf(format string, args ...any) Text {
+	safeArgs := make([]any, len(args))
+	for i, arg := range args {
+		if t, ok := arg.(Text); ok {
+			safeArgs[i] = string(t)
+		} else {
+			safeArgs[i] = escaped{arg}
+		}
+	}
+	return Text(fmt.Sprintf(format, safeArgs...))
+}
+
+// escaped formats its value with the verb and flags it is formatted with, then escapes the result.
+type escaped struct {
+	value any
+}
+
+// Format implements fmt.Formatter.
+func (e escaped) Format(f fmt.State, verb rune) {
+	fmt.Fprint(f, Escape(fmt.Sprintf(fmt.FormatString(f, verb), e.value)))
+}
+
+// safe returns part unchanged if it is Text, and escaped otherwise.
+func safe(part any) Text {
+	if t, ok := part.(Text); ok {
+		return t
+	}
+	return Escape(fmt.Sprint(part))
+}
@@ -0,0 +1,103 @@
+package mrkdwn_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/mrkdwn"
+)
+
+func TestEscape(t *testing.T) {
+	got := mrkdwn.Escape("<!channel> & <@U1>")
+	want := mrkdwn.Text("&lt;!channel&gt; &amp; &lt;@U1&gt;")
+	if got != want {
+		t.Errorf("Escape = %q, want %q", got, want)
+	}
+}
+
+func TestMentions(t *testing.T) {
+	tests := []struct {
+		got, want mrkdwn.Text
+	}{
+		{mrkdwn.User("U123"), "<@U123>"},
+		{mrkdwn.Channel("C123"), "<#C123>"},
+		{mrkdwn.UserGroup("S123"), "<!subteam^S123>"},
+		{mrkdwn.Here(), "<!here>"},
+		{mrkdwn.ChannelMention(), "<!channel>"},
+		{mrkdwn.Everyone(), "<!everyone>"},
+		{mrkdwn.User("U1><!channel"), "<@U1&gt;&lt;!channel>"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestFormatting(t *testing.T) {
+	tests := []struct {
+		name      string
+		got, want mrkdwn.Text
+	}{
+		{"link", mrkdwn.Link("https://example.com?a=1&b=2", "Docs <here>"), "<https://example.com?a=1&amp;b=2|Docs &lt;here&gt;>"},
+		{"bare link", mrkdwn.Link("https://example.com", ""), "<https://example.com>"},
+		{"link with pipe", mrkdwn.Link("https://example.com/a|b", "Docs"), "<https://example.com/a%7Cb|Docs>"},
+		{"email", mrkdwn.Email("a@example.com", "Mail"), "<mailto:a@example.com|Mail>"},
+		{"bold", mrkdwn.Bold(" hi "), " *hi* "},
+		{"italic", mrkdwn.Italic("hi"), "_hi_"},
+		{"strike", mrkdwn.Strike("hi"), "~hi~"},
+		{"empty bold", mrkdwn.Bold("  "), "  "},
+		{"code", mrkdwn.Code("a`b<c"), "`aˋb&lt;c`"},
+		{"code block", mrkdwn.CodeBlock("x ``` y"), "```\nx ``ˋ y\n```"},
+		{"quote", mrkdwn.Quote("one\n<two>"), "> one\n> &lt;two&gt;"},
+		{"emoji", mrkdwn.Emoji(":rocket:"), ":rocket:"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	ts := time.Date(2014, 2, 18, 14, 39, 42, 0, time.UTC)
+	if got, want := mrkdwn.Date(ts, mrkdwn.DateShort), mrkdwn.Text("<!date^1392734382^{date_short}|2014-02-18 14:39 UTC>"); got != want {
+		t.Errorf("Date = %q, want %q", got, want)
+	}
+	if got, want := mrkdwn.DateWithFallback(ts, mrkdwn.DateShort+" at "+mrkdwn.Time, "Feb 18"), mrkdwn.Text("<!date^1392734382^{date_short} at {time}|Feb 18>"); got != want {
+		t.Errorf("DateWithFallback = %q, want %q", got, want)
+	}
+	if got, want := mrkdwn.DateLink(ts, mrkdwn.Ago, "https://example.com"), mrkdwn.Text("<!date^1392734382^{ago}^https://example.com|2014-02-18 14:39 UTC>"); got != want {
+		t.Errorf("DateLink = %q, want %q", got, want)
+	}
+	if got, want := mrkdwn.DateLink(ts, mrkdwn.Ago, "https://example.com/?q=a|b^c>d&e"), mrkdwn.Text("<!date^1392734382^{ago}^https://example.com/?q=a%7Cb%5Ec%3Ed&amp;e|2014-02-18 14:39 UTC>"); got != want {
+		t.Errorf("DateLink = %q, want %q", got, want)
+	}
+}
+
+func TestComposition(t *testing.T) {
+	untrusted := "<!channel> v1.2"
+	got := mrkdwn.Sprintf("%s deployed %s (%d files): %v", mrkdwn.User("U1"), untrusted, 3, errors.New("a<b"))
+	want := mrkdwn.Text("<@U1> deployed &lt;!channel&gt; v1.2 (3 files): a&lt;b")
+	if got != want {
+		t.Errorf("Sprintf = %q, want %q", got, want)
+	}
+
+	type payload struct{ Name string }
+	got = mrkdwn.Sprintf("%c%q %v %x %5.2f", '<', "a>b", payload{"<!here>"}, "&", 1.5)
+	want = "&lt;\"a&gt;b\" {&lt;!here&gt;} 26  1.50"
+	if got != want {
+		t.Errorf("Sprintf verbs = %q, want %q", got, want)
+	}
+
+	got = mrkdwn.Concat(mrkdwn.Emoji("rocket"), " ", untrusted, " ", 42)
+	want = ":rocket: &lt;!channel&gt; v1.2 42"
+	if got != want {
+		t.Errorf("Concat = %q, want %q", got, want)
+	}
+
+	if got := mrkdwn.Raw("<!here>").String(); got != "<!here>" {
+		t.Errorf("Raw = %q", got)
+	}
+}
@@ -0,0 +1,265 @@
+package mrkdwn
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NodeKind identifies the type of a Node.
+type NodeKind int
+
+// Node kinds produced by Parse.
+const (
+	KindText             NodeKind = iota // Text holds the unescaped text
+	KindBold                             // Children hold the content
+	KindItalic                           // Children hold the content
+	KindStrike                           // Children hold the content
+	KindCode                             // Text holds the code
+	KindPreformatted                     // Text holds the contents of a ``` block
+	KindQuote                            // Children hold the quoted lines
+	KindLink                             // URL and the optional label in Text
+	KindUserMention                      // ID and the optional label in Text
+	KindChannelMention                   // ID and the optional label in Text
+	KindUserGroupMention                 // ID and the optional label in Text
+	KindSpecialMention                   // Text holds here, channel or everyone
+	KindDate                             // Timestamp, Format, optional URL and the fallback in Text
+	KindEmoji                            // Text holds the name without colons
+)
+
+// Node is an element of parsed mrkdwn.
+type Node struct {
+	Kind      NodeKind
+	Text      string
+	ID        string
+	URL       string
+	Format    string
+	Timestamp int64
+	Children  []Node
+}
+
+// Parse parses Slack mrkdwn, such as the Text of a message event, into nodes.
+// Entities are decoded, so the text of the nodes is what users see.
+func Parse(text string) []Node {
+	var nodes []Node
+	for {
+		start := strings.Index(text, "```")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(text[start+3:], "```")
+		if end < 0 {
+			break
+		}
+		nodes = append(nodes, parseLines(text[:start])...)
+		code := text[start+3 : start+3+end]
+		nodes = append(nodes, Node{Kind: KindPreformatted, Text: unescape(strings.TrimSuffix(strings.TrimPrefix(code, "\n"), "\n"))})
+		text = text[start+3+end+3:]
+	}
+	return append(nodes, parseLines(text)...)
+}
+
+// parseLines parses text without preformatted blocks, grouping consecutive quoted lines.
+// Line breaks between groups are kept as text.
+func parseLines(text string) []Node {
+	if text == "" {
+		return nil
+	}
+	var nodes []Node
+	var group []string
+	inQuote := false
+	flush := func() {
+		if len(nodes) > 0 {
+			nodes = append(nodes, Node{Kind: KindText, Text: "\n"})
+		}
+		if inQuote {
+			nodes = append(nodes, Node{Kind: KindQuote, Children: parseInline(strings.Join(group, "\n"))})
+		} else {
+			nodes = append(nodes, parseInline(strings.Join(group, "\n"))...)
+		}
+		group = nil
+	}
+	for i, line := range strings.Split(text, "\n") {
+		rest, quoted := quotedLine(line)
+		if i > 0 && quoted != inQuote {
+			flush()
+		}
+		inQuote = quoted
+		if quoted {
+			line = rest
+		}
+		group = append(group, line)
+	}
+	flush()
+	return mergeText(nodes)
+}
+
+// mergeText merges adjacent text nodes.
+func mergeText(nodes []Node) []Node {
+	merged := nodes[:0]
+	for _, n := range nodes {
+		if last := len(merged) - 1; last >= 0 && n.Kind == KindText && merged[last].Kind == KindText {
+			merged[last].Text += n.Text
+			continue
+		}
+		merged = append(merged, n)
+	}
+	return merged
+}
+
+// quotedLine returns the content of a line starting with a quote marker.
+func quotedLine(line string) (string, bool) {
+	for _, marker := range []string{"&gt;", ">"} {
+		if rest, ok := strings.CutPrefix(line, marker); ok {
+			return strings.TrimPrefix(rest, " "), true
+		}
+	}
+	return "", false
+}
+
+// emojiPattern matches an emoji name with an optional skin tone.
+var emojiPattern = regexp.MustCompile(`^:([a-z0-9_+'-]+(?:::skin-tone-[2-6])?):`)
+
+// parseInline parses the inline syntax of one or more lines.
+func parseInline(text string) []Node {
+	var nodes []Node
+	var plain strings.Builder
+	flush := func() {
+		if plain.Len() > 0 {
+			nodes = append(nodes, Node{Kind: KindText, Text: plain.String()})
+			plain.Reset()
+		}
+	}
+	add := func(n Node) {
+		flush()
+		nodes = append(nodes, n)
+	}
+
+	for i := 0; i < len(text); {
+		switch c := text[i]; c {
+		case '<':
+			if end := strings.IndexByte(text[i:], '>'); end > 0 {
+				if n, ok := parseAngle(text[i+1 : i+end]); ok {
+					add(n)
+					i += end + 1
+					continue
+				}
+			}
+		case '`':
+			if end := strings.IndexByte(text[i+1:], '`'); end > 0 && !strings.Contains(text[i+1:i+1+end], "\n") {
+				add(Node{Kind: KindCode, Text: unescape(text[i+1 : i+1+end])})
+				i += end + 2
+				continue
+			}
+		case '*', '_', '~':
+			if end, ok := closingMarker(text, i); ok {
+				kind := map[byte]NodeKind{'*': KindBold, '_': KindItalic, '~': KindStrike}[c]
+				add(Node{Kind: kind, Children: parseInline(text[i+1 : end])})
+				i = end + 1
+				continue
+			}
+		case ':':
+			if m := emojiPattern.FindStringSubmatch(text[i:]); m != nil && !wordBefore(text, i) {
+				add(Node{Kind: KindEmoji, Text: m[1]})
+				i += len(m[0])
+				continue
+			}
+		case '&':
+			if entity, decoded, ok := entityAt(text[i:]); ok {
+				plain.WriteString(decoded)
+				i += len(entity)
+				continue
+			}
+		}
+		plain.WriteByte(text[i])
+		i++
+	}
+	flush()
+	return nodes
+}
+
+// closingMarker finds the marker closing the one at text[start], on the same line. Markers
+// must not touch letters or digits outside and must not touch spaces inside.
+func closingMarker(text string, start int) (int, bool) {
+	marker := text[start]
+	if wordBefore(text, start) || start+1 >= len(text) || unicode.IsSpace(rune(text[start+1])) || text[start+1] == marker {
+		return 0, false
+	}
+	for i := start + 2; i < len(text); i++ {
+		switch text[i] {
+		case '\n':
+			return 0, false
+		case marker:
+			if !unicode.IsSpace(rune(text[i-1])) && !wordAfter(text, i+1) {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// parseAngle parses the contents of a <...> control sequence.
+func parseAngle(inner string) (Node, bool) {
+	target, label, _ := strings.Cut(inner, "|")
+	label = unescape(label)
+	switch {
+	case strings.HasPrefix(target, "@"):
+		return Node{Kind: KindUserMention, ID: target[1:], Text: label}, len(target) > 1
+	case strings.HasPrefix(target, "#"):
+		return Node{Kind: KindChannelMention, ID: target[1:], Text: label}, len(target) > 1
+	case strings.HasPrefix(target, "!subteam^"):
+		return Node{Kind: KindUserGroupMention, ID: strings.TrimPrefix(target, "!subteam^"), Text: label}, true
+	case strings.HasPrefix(target, "!date^"):
+		parts := strings.SplitN(strings.TrimPrefix(target, "!date^"), "^", 3)
+		ts, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || len(parts) < 2 {
+			return Node{}, false
+		}
+		n := Node{Kind: KindDate, Timestamp: ts, Format: unescape(parts[1]), Text: label}
+		if len(parts) == 3 {
+			n.URL = unescape(parts[2])
+		}
+		return n, true
+	case target == "!here", target == "!channel", target == "!everyone":
+		return Node{Kind: KindSpecialMention, Text: target[1:]}, true
+	case strings.HasPrefix(target, "!"):
+		return Node{}, false
+	case strings.Contains(target, ":") && !strings.ContainsAny(target, " \n"):
+		return Node{Kind: KindLink, URL: unescape(target), Text: label}, true
+	}
+	return Node{}, false
+}
+
+// entities are the escapes Slack uses in mrkdwn.
+var entities = []struct{ entity, decoded string }{
+	{"&amp;", "&"}, {"&lt;", "<"}, {"&gt;", ">"},
+}
+
+// entityAt returns the entity at the start of text and its decoded form.
+func entityAt(text string) (string, string, bool) {
+	for _, e := range entities {
+		if strings.HasPrefix(text, e.entity) {
+			return e.entity, e.decoded, true
+		}
+	}
+	return "", "", false
+}
+
+// unescape decodes the entities in text.
+func unescape(text string) string {
+	return strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">").Replace(text)
+}
+
+// wordBefore reports whether the character before text[i] is a letter or digit.
+func wordBefore(text string, i int) bool {
+	r, _ := utf8.DecodeLastRuneInString(text[:i])
+	return i > 0 && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// wordAfter reports whether the character at text[i] is a letter or digit.
+func wordAfter(text string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(text[i:])
+	return i < len(text) && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
@@ -0,0 +1,106 @@
+package mrkdwn_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ren3gadem4rm0t/slack-go-helpers/mrkdwn"
+)
+
+func TestParse(t *testing.T) {
+	got := mrkdwn.Parse("Hi <@U1>, *see _<https://x.com?a=1&amp;b=2|docs>_* &lt;3")
+	want := []mrkdwn.Node{
+		{Kind: mrkdwn.KindText, Text: "Hi "},
+		{Kind: mrkdwn.KindUserMention, ID: "U1"},
+		{Kind: mrkdwn.KindText, Text: ", "},
+		{Kind: mrkdwn.KindBold, Children: []mrkdwn.Node{
+			{Kind: mrkdwn.KindText, Text: "see "},
+			{Kind: mrkdwn.KindItalic, Children: []mrkdwn.Node{
+				{Kind: mrkdwn.KindLink, URL: "https://x.com?a=1&b=2", Text: "docs"},
+			}},
+		}},
+		{Kind: mrkdwn.KindText, Text: " <3"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseSpecialSequences(t *testing.T) {
+	got := mrkdwn.Parse("<!here> <!subteam^S1|@devs> <!date^1392734382^{date_short}^https://x.com|Feb 18> :thumbsup::skin-tone-2:")
+	want := []mrkdwn.Node{
+		{Kind: mrkdwn.KindSpecialMention, Text: "here"},
+		{Kind: mrkdwn.KindText, Text: " "},
+		{Kind: mrkdwn.KindUserGroupMention, ID: "S1", Text: "@devs"},
+		{Kind: mrkdwn.KindText, Text: " "},
+		{Kind: mrkdwn.KindDate, Timestamp: 1392734382, Format: "{date_short}", URL: "https://x.com", Text: "Feb 18"},
+		{Kind: mrkdwn.KindText, Text: " "},
+		{Kind: mrkdwn.KindEmoji, Text: "thumbsup::skin-tone-2"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseLiteralMarkers(t *testing.T) {
+	for _, text := range []string{"a*b*c", "snake_case_name", "* not bold *", "10:30:45", "<not a link>", "*open"} {
+		nodes := mrkdwn.Parse(text)
+		if len(nodes) != 1 || nodes[0].Kind != mrkdwn.KindText || nodes[0].Text != text {
+			t.Errorf("Parse(%q) = %+v, want literal text", text, nodes)
+		}
+	}
+}
+
+func TestRenderPlainText(t *testing.T) {
+	text := "&gt; quoted *line*\n&gt; <@U1> said\nsee <https://x.com|docs> in <#C1>\n```\nfn main() {}\n```\n<!date^1392734382^{date_short}|Feb 18>"
+	users := mrkdwn.WithUserResolver(func(id string) string {
+		return map[string]string{"U1": "alice"}[id]
+	})
+	got := mrkdwn.ToPlainText(text, users)
+	want := "> quoted line\n> @alice said\nsee docs (https://x.com) in #C1\nfn main() {}\nFeb 18"
+	if got != want {
+		t.Errorf("ToPlainText =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	nodes := mrkdwn.Parse("*bold* ~gone~ `a<b` <https://x.com?a=1&amp;b=2|x & y> <#C1|general>\n<!date^1392734382^{date}>")
+	got := mrkdwn.RenderHTML(nodes)
+	want := `<strong>bold</strong> <del>gone</del> <code>a&lt;b</code> <a href="https://x.com?a=1&amp;b=2">x &amp; y</a> <span class="mention">#general</span><br>` +
+		`<time datetime="2014-02-18T14:39:42Z">2014-02-18 14:39 UTC</time>`
+	if got != want {
+		t.Errorf("RenderHTML =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderHTMLUnsafeLinks(t *testing.T) {
+	nodes := mrkdwn.Parse("<javascript:alert(1)|click> <JavaScript:alert(1)> <data:text/html,x|data> <mailto:a@b.co|mail> <slack://open|app>")
+	got := mrkdwn.RenderHTML(nodes)
+	want := `click JavaScript:alert(1) data <a href="mailto:a@b.co">mail</a> <a href="slack://open">app</a>`
+	if got != want {
+		t.Errorf("RenderHTML =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderMarkdownEscapesURLs(t *testing.T) {
+	nodes := mrkdwn.Parse("<https://x.com/a_(b)|docs> <https://x.com/a&gt;b>")
+	got := mrkdwn.RenderMarkdown(nodes)
+	want := "[docs](https://x.com/a_%28b%29) <https://x.com/a%3Eb>"
+	if got != want {
+		t.Errorf("RenderMarkdown = %q, want %q", got, want)
+	}
+
+	link := mrkdwn.Node{Kind: mrkdwn.KindLink, URL: "https://x.com/a b", Text: "docs"}
+	if got := mrkdwn.RenderMarkdown([]mrkdwn.Node{link}); got != "[docs](https://x.com/a%20b)" {
+		t.Errorf("RenderMarkdown = %q, want a percent-encoded space", got)
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	nodes := mrkdwn.Parse("*bold _it_* ~gone~ `x` snake_case <https://x.com|docs> <https://y.com>\n&gt; quote\n```\ncode\n```")
+	got := mrkdwn.RenderMarkdown(nodes, mrkdwn.WithChannelResolver(func(string) string { return "unused" }))
+	want := "**bold _it_** ~~gone~~ `x` snake\\_case [docs](https://x.com) <https://y.com>\n> quote\n```\ncode\n```"
+	if got != want {
+		t.Errorf("RenderMarkdown =\n%q\nwant\n%q", got, want)
+	}
+}
@@ -0,0 +1,264 @@
+package mrkdwn
+
+import (
+	"html"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Resolver returns the display name for a user, channel or user group ID, or "" if unknown.
+type Resolver func(id string) string
+
+// RenderOption configures how mentions are rendered.
+type RenderOption func(*renderer)
+
+// WithUserResolver resolves user mentions that carry no label.
+func WithUserResolver(resolve Resolver) RenderOption {
+	return func(r *renderer) {
+		r.user = resolve
+	}
+}
+
+// WithChannelResolver resolves channel mentions that carry no label.
+func WithChannelResolver(resolve Resolver) RenderOption {
+	return func(r *renderer) {
+		r.channel = resolve
+	}
+}
+
+// WithUserGroupResolver resolves user group mentions that carry no label.
+func WithUserGroupResolver(resolve Resolver) RenderOption {
+	return func(r *renderer) {
+		r.usergroup = resolve
+	}
+}
+
+// renderer renders nodes in one output format.
+type renderer struct {
+	user, channel, usergroup Resolver
+	b                        *strings.Builder
+	format                   func(r *renderer, n Node)
+}
+
+// ToPlainText parses mrkdwn and renders it as plain text.
+func ToPlainText(text string, opts ...RenderOption) string {
+	return RenderPlainText(Parse(text), opts...)
+}
+
+// RenderPlainText renders nodes as plain text for searching, logging or forwarding.
+// Mentions become @name or #name, links show their URL after a label, and dates
+// show their fallback text.
+func RenderPlainText(nodes []Node, opts ...RenderOption) string {
+	return render(nodes, plainTextNode, opts)
+}
+
+// RenderHTML renders nodes as an HTML fragment with escaped text. Only http, https, mailto
+// and slack links become anchors; links with any other scheme render as their label.
+func RenderHTML(nodes []Node, opts ...RenderOption) string {
+	return render(nodes, htmlNode, opts)
+}
+
+// RenderMarkdown renders nodes as CommonMark, percent-encoding the characters that would
+// end a link destination.
+func RenderMarkdown(nodes []Node, opts ...RenderOption) string {
+	return render(nodes, markdownNode, opts)
+}
+
+// render renders nodes with format.
+func render(nodes []Node, format func(r *renderer, n Node), opts []RenderOption) string {
+	r := &renderer{b: &strings.Builder{}, format: format}
+	for _, opt := range opts {
+		opt(r)
+	}
+	r.nodes(nodes)
+	return r.b.String()
+}
+
+// nodes renders each node.
+func (r *renderer) nodes(nodes []Node) {
+	for _, n := range nodes {
+		r.format(r, n)
+	}
+}
+
+// children renders nodes into a separate string.
+func (r *renderer) children(nodes []Node) string {
+	saved := r.b
+	r.b = &strings.Builder{}
+	r.nodes(nodes)
+	out := r.b.String()
+	r.b = saved
+	return out
+}
+
+// mention returns the display text of a mention: its label, the resolved name or the ID,
+// prefixed with sigil.
+func (r *renderer) mention(n Node) string {
+	var resolve Resolver
+	sigil := "@"
+	switch n.Kind {
+	case KindUserMention:
+		resolve = r.user
+	case KindChannelMention:
+		resolve, sigil = r.channel, "#"
+	case KindUserGroupMention:
+		resolve = r.usergroup
+	case KindSpecialMention:
+		return "@" + n.Text
+	}
+	name := n.Text
+	if name == "" && resolve != nil {
+		name = resolve(n.ID)
+	}
+	if name == "" {
+		name = n.ID
+	}
+	return sigil + strings.TrimPrefix(name, sigil)
+}
+
+// dateText returns the fallback text of a date, or the date in UTC if there is none.
+func dateText(n Node) string {
+	if n.Text != "" {
+		return n.Text
+	}
+	return time.Unix(n.Timestamp, 0).UTC().Format("2006-01-02 15:04 UTC")
+}
+
+// isMention reports whether n is any kind of mention.
+func isMention(n Node) bool {
+	switch n.Kind {
+	case KindUserMention, KindChannelMention, KindUserGroupMention, KindSpecialMention:
+		return true
+	}
+	return false
+}
+
+// plainTextNode renders n as plain text.
+func plainTextNode(r *renderer, n Node) {
+	switch {
+	case isMention(n):
+		r.b.WriteString(r.mention(n))
+	case n.Kind == KindBold, n.Kind == KindItalic, n.Kind == KindStrike:
+		r.nodes(n.Children)
+	case n.Kind == KindQuote:
+		r.b.WriteString(prefixLines(r.children(n.Children), "> "))
+	case n.Kind == KindLink:
+		if n.Text == "" || n.Text == n.URL || n.Text == strings.TrimPrefix(n.URL, "mailto:") {
+			r.b.WriteString(strings.TrimPrefix(n.URL, "mailto:"))
+		} else {
+			r.b.WriteString(n.Text + " (" + n.URL + ")")
+		}
+	case n.Kind == KindDate:
+		r.b.WriteString(dateText(n))
+	case n.Kind == KindEmoji:
+		r.b.WriteString(":" + n.Text + ":")
+	default:
+		r.b.WriteString(n.Text)
+	}
+}
+
+// htmlNode renders n as HTML.
+func htmlNode(r *renderer, n Node) {
+	esc := html.EscapeString
+	switch {
+	case isMention(n):
+		r.b.WriteString(`<span class="mention">` + esc(r.mention(n)) + `</span>`)
+	case n.Kind == KindBold:
+		r.b.WriteString("<strong>" + r.children(n.Children) + "</strong>")
+	case n.Kind == KindItalic:
+		r.b.WriteString("<em>" + r.children(n.Children) + "</em>")
+	case n.Kind == KindStrike:
+		r.b.WriteString("<del>" + r.children(n.Children) + "</del>")
+	case n.Kind == KindCode:
+		r.b.WriteString("<code>" + esc(n.Text) + "</code>")
+	case n.Kind == KindPreformatted:
+		r.b.WriteString("<pre>" + esc(n.Text) + "</pre>")
+	case n.Kind == KindQuote:
+		r.b.WriteString("<blockquote>" + r.children(n.Children) + "</blockquote>")
+	case n.Kind == KindLink:
+		label := n.Text
+		if label == "" {
+			label = strings.TrimPrefix(n.URL, "mailto:")
+		}
+		if !linkableURL(n.URL) {
+			r.b.WriteString(esc(label))
+			break
+		}
+		r.b.WriteString(`<a href="` + esc(n.URL) + `">` + esc(label) + "</a>")
+	case n.Kind == KindDate:
+		datetime := time.Unix(n.Timestamp, 0).UTC().Format(time.RFC3339)
+		r.b.WriteString(`<time datetime="` + datetime + `">` + esc(dateText(n)) + "</time>")
+	case n.Kind == KindEmoji:
+		r.b.WriteString(esc(":" + n.Text + ":"))
+	default:
+		r.b.WriteString(strings.ReplaceAll(esc(n.Text), "\n", "<br>"))
+	}
+}
+
+// htmlURLSchemes are the URL schemes RenderHTML renders as links.
+var htmlURLSchemes = []string{"http", "https", "mailto", "slack"}
+
+// linkableURL reports whether rawURL parses and uses one of htmlURLSchemes.
+func linkableURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && slices.Contains(htmlURLSchemes, u.Scheme)
+}
+
+// markdownURLEscaper percent-encodes the characters that end a CommonMark link destination.
+var markdownURLEscaper = strings.NewReplacer(
+	" ", "%20", "\n", "%0A", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E",
+)
+
+// markdownEscaper escapes the characters CommonMark would interpret inline.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "`", "\\`", "[", `\[`, "]", `\]`, "<", `\<`, ">", `\>`,
+)
+
+// markdownNode renders n as CommonMark.
+func markdownNode(r *renderer, n Node) {
+	esc := markdownEscaper.Replace
+	switch {
+	case isMention(n):
+		r.b.WriteString(esc(r.mention(n)))
+	case n.Kind == KindBold:
+		r.b.WriteString("**" + r.children(n.Children) + "**")
+	case n.Kind == KindItalic:
+		r.b.WriteString("_" + r.children(n.Children) + "_")
+	case n.Kind == KindStrike:
+		r.b.WriteString("~~" + r.children(n.Children) + "~~")
+	case n.Kind == KindCode:
+		fence := "`"
+		for strings.Contains(n.Text, fence) {
+			fence += "`"
+		}
+		r.b.WriteString(fence + n.Text + fence)
+	case n.Kind == KindPreformatted:
+		fence := "```"
+		for strings.Contains(n.Text, fence) {
+			fence += "`"
+		}
+		r.b.WriteString(fence + "\n" + n.Text + "\n" + fence)
+	case n.Kind == KindQuote:
+		r.b.WriteString(prefixLines(r.children(n.Children), "> "))
+	case n.Kind == KindLink:
+		target := markdownURLEscaper.Replace(n.URL)
+		if n.Text == "" || n.Text == n.URL {
+			r.b.WriteString("<" + target + ">")
+		} else {
+			r.b.WriteString("[" + esc(n.Text) + "](" + target + ")")
+		}
+	case n.Kind == KindDate:
+		r.b.WriteString(esc(dateText(n)))
+	case n.Kind == KindEmoji:
+		r.b.WriteString(esc(":" + n.Text + ":"))
+	default:
+		r.b.WriteString(esc(n.Text))
+	}
+}
+
+// prefixLines prefixes every line of text.
+func prefixLines(text, prefix string) string {
+	return prefix + strings.ReplaceAll(text, "\n", "\n"+prefix)
+}